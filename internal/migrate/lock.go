@@ -0,0 +1,201 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pballentine13/liteforge/internal/orm"
+)
+
+// migrationLockID is the arbitrary fixed key every migrator takes Postgres's
+// session-level advisory lock on, so that concurrent migrators across
+// separate connections/processes serialize against each other rather than
+// each proceeding on its own connection.
+const migrationLockID = 78412 // arbitrary; unique enough not to collide with app locks
+
+// execer is the subset of *sql.DB and *sql.Tx that reading/writing
+// schema_migrations needs, so callers can run against either depending on
+// whether the driver's lock is itself a transaction.
+type execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+}
+
+// lock represents a held advisory lock, plus enough state to scope each
+// migration step to a transaction correctly under it.
+type lock struct {
+	// tx is non-nil only for SQLite, where the lock is itself a write
+	// transaction on a dedicated connection: SQLite only ever allows one
+	// writer at a time file-wide, so that transaction blocks every other
+	// connection from writing the moment it performs its first write,
+	// regardless of BEGIN mode. Because of that, a migration step can't
+	// open its own Tx from ds.DB without deadlocking against this one, so
+	// runStep instead runs it as a SAVEPOINT inside tx. It is committed,
+	// not rolled back, by release - that commit is what actually persists
+	// every step that ran under the lock.
+	//
+	// tx is nil for Postgres, whose pg_advisory_lock is genuinely
+	// session-scoped and doesn't block other connections from writing, so
+	// each step there uses a normal, independent ds.Adapter.BeginTx(ds.DB).
+	tx        *sql.Tx
+	releaseFn func() error
+}
+
+// execer returns what schema_migrations reads/writes should run against:
+// the lock's held Tx if it has one, or ds.DB otherwise.
+func (lk *lock) execer(ds *orm.Datastore) execer {
+	if lk.tx != nil {
+		return lk.tx
+	}
+	return ds.DB
+}
+
+// release ends the lock, committing its transaction for SQLite or releasing
+// the advisory lock for Postgres.
+func (lk *lock) release() error {
+	return lk.releaseFn()
+}
+
+// runStep runs fn (a migration's Up or Down) as one atomic unit and, on
+// success, records (forward) or removes (rollback) m's row in
+// schema_migrations as part of the same unit.
+func (lk *lock) runStep(ds *orm.Datastore, m Migration, fn func(*sql.Tx) error, forward bool) error {
+	if lk.tx != nil {
+		return lk.runStepInSavepoint(ds, m, fn, forward)
+	}
+	return lk.runStepInOwnTx(ds, m, fn, forward)
+}
+
+// runStepInSavepoint runs fn as a SAVEPOINT within the lock's own
+// transaction, so a failed step rolls back only that step without
+// disturbing the lock or any step already applied alongside it.
+func (lk *lock) runStepInSavepoint(ds *orm.Datastore, m Migration, fn func(*sql.Tx) error, forward bool) error {
+	name := fmt.Sprintf("migration_%d", m.ID)
+	if _, err := lk.tx.Exec("SAVEPOINT " + name); err != nil {
+		return fmt.Errorf("failed to create savepoint: %w", err)
+	}
+
+	if err := func() error {
+		if fn != nil {
+			if err := fn(lk.tx); err != nil {
+				return err
+			}
+		}
+		return recordHistory(lk.tx, ds.Adapter, m, forward)
+	}(); err != nil {
+		lk.tx.Exec("ROLLBACK TO SAVEPOINT " + name)
+		return err
+	}
+
+	if _, err := lk.tx.Exec("RELEASE SAVEPOINT " + name); err != nil {
+		return fmt.Errorf("failed to release savepoint: %w", err)
+	}
+	return nil
+}
+
+// runStepInOwnTx runs fn inside its own, independently committed
+// transaction, opened fresh from ds.DB.
+func (lk *lock) runStepInOwnTx(ds *orm.Datastore, m Migration, fn func(*sql.Tx) error, forward bool) error {
+	tx, err := ds.Adapter.BeginTx(ds.DB)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if fn != nil {
+		if err := fn(tx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := recordHistory(tx, ds.Adapter, m, forward); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// recordHistory inserts (forward) or deletes (rollback) m's row in
+// schema_migrations through tx.
+func recordHistory(tx *sql.Tx, adapter orm.DBAdapter, m Migration, forward bool) error {
+	var err error
+	if forward {
+		_, err = tx.Exec("INSERT INTO schema_migrations (version, name, applied_at) VALUES ("+
+			adapter.GetPlaceholder(1)+", "+adapter.GetPlaceholder(2)+", CURRENT_TIMESTAMP)", m.ID, m.Name)
+	} else {
+		_, err = tx.Exec("DELETE FROM schema_migrations WHERE version = "+adapter.GetPlaceholder(1), m.ID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to record migration history: %w", err)
+	}
+	return nil
+}
+
+// acquireLock takes a driver-appropriate lock that prevents two migrators
+// from applying migrations concurrently against the same database.
+func acquireLock(ds *orm.Datastore) (*lock, error) {
+	switch ds.Adapter.(type) {
+	case *orm.SQLiteAdapter:
+		return acquireSQLiteLock(ds.DB)
+	case *orm.PostgresAdapter:
+		return acquirePostgresLock(ds.DB)
+	default:
+		return nil, fmt.Errorf("migrate: advisory locking is not supported for this adapter")
+	}
+}
+
+// acquireSQLiteLock opens a dedicated connection and starts a transaction on
+// it - see lock.tx's doc comment for why that transaction doubles as both
+// the lock and the scope every migration step runs under.
+func acquireSQLiteLock(db *sql.DB) (*lock, error) {
+	conn, err := db.Conn(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open lock connection: %w", err)
+	}
+
+	// A plain deferred transaction doesn't take SQLite's write lock until
+	// its first write, so two concurrent migrators could both start here,
+	// both read the same unapplied migration set, and only the second to
+	// actually write would be serialized (as a SQLITE_BUSY error) instead
+	// of blocking up front. Requesting sql.LevelSerializable makes the
+	// driver open this as an immediate/exclusive transaction, taking the
+	// write lock right away so the second migrator serializes here.
+	tx, err := conn.BeginTx(context.Background(), &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to begin lock transaction: %w", err)
+	}
+
+	return &lock{
+		tx: tx,
+		releaseFn: func() error {
+			err := tx.Commit()
+			conn.Close()
+			return err
+		},
+	}, nil
+}
+
+// acquirePostgresLock takes a session-level pg_advisory_lock on a dedicated
+// connection, held regardless of which transaction each migration step
+// opens, and released on that same connection.
+func acquirePostgresLock(db *sql.DB) (*lock, error) {
+	conn, err := db.Conn(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open lock connection: %w", err)
+	}
+
+	if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_lock($1)", migrationLockID); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to take pg_advisory_lock: %w", err)
+	}
+
+	return &lock{
+		releaseFn: func() error {
+			_, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLockID)
+			conn.Close()
+			return err
+		},
+	}, nil
+}
@@ -0,0 +1,178 @@
+// Package migrate provides versioned schema migrations on top of
+// orm.Datastore, complementing CreateTable (which only knows how to create
+// a table from a model's current shape) with the kind of schema evolution -
+// adding/renaming columns, backfills, data migrations - that reflection-
+// driven DDL can't express. It mirrors the migration pattern used by dex's
+// SQL storage: ordered, numbered steps tracked in a history table, guarded
+// by an advisory lock so two processes don't race to apply the same
+// migration twice.
+package migrate
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"github.com/pballentine13/liteforge/internal/orm"
+)
+
+// Migration is one versioned, reversible schema change. ID must be unique
+// and is used both to order migrations and to record which have run; Up and
+// Down each receive the *sql.Tx the migration runs inside, and returning a
+// non-nil error rolls that step back without recording the version.
+type Migration struct {
+	ID   int
+	Name string
+	Up   func(*sql.Tx) error
+	Down func(*sql.Tx) error
+}
+
+// Status reports which of a set of migrations have already run against a
+// Datastore and which haven't, as returned by MigrationStatus.
+type Status struct {
+	Applied []Migration
+	Pending []Migration
+}
+
+// migrationsTableSQL creates the schema_migrations history table. The
+// statement is dialect-neutral: INTEGER PRIMARY KEY, TEXT and TIMESTAMP all
+// mean the same thing to both SQLiteAdapter and PostgresAdapter.
+const migrationsTableSQL = `CREATE TABLE IF NOT EXISTS schema_migrations (
+	version INTEGER PRIMARY KEY,
+	name TEXT,
+	applied_at TIMESTAMP
+)`
+
+// Migrate applies every migration in migrations that hasn't already run,
+// in ascending ID order. It is equivalent to MigrateTo with target set to
+// the highest ID in migrations.
+func Migrate(ds *orm.Datastore, migrations []Migration) error {
+	sorted := sortedByID(migrations)
+	if len(sorted) == 0 {
+		return nil
+	}
+	return MigrateTo(ds, migrations, sorted[len(sorted)-1].ID)
+}
+
+// MigrateTo brings the database to target: applying any unapplied migration
+// with ID <= target in ascending order if target is above the current
+// version, or rolling back any applied migration with ID > target in
+// descending order if target is below it. The whole call is guarded by an
+// advisory lock so two migrators can't run concurrently against the same
+// database; see acquireLock for how each step is scoped to a transaction
+// under that lock.
+func MigrateTo(ds *orm.Datastore, migrations []Migration, target int) error {
+	if ds == nil || ds.DB == nil || ds.Adapter == nil {
+		return fmt.Errorf("datastore, database connection, or adapter was nil")
+	}
+
+	lk, err := acquireLock(ds)
+	if err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer lk.release()
+
+	db := lk.execer(ds)
+	if _, err := db.Exec(migrationsTableSQL); err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	applied, err := appliedVersions(db)
+	if err != nil {
+		return err
+	}
+
+	sorted := sortedByID(migrations)
+	if target >= currentVersion(applied) {
+		for _, m := range sorted {
+			if m.ID > target || applied[m.ID] {
+				continue
+			}
+			if err := lk.runStep(ds, m, m.Up, true); err != nil {
+				return fmt.Errorf("migration %d (%s) failed: %w", m.ID, m.Name, err)
+			}
+		}
+		return nil
+	}
+
+	for i := len(sorted) - 1; i >= 0; i-- {
+		m := sorted[i]
+		if m.ID <= target || !applied[m.ID] {
+			continue
+		}
+		if m.Down == nil {
+			return fmt.Errorf("migration %d (%s) has no Down step", m.ID, m.Name)
+		}
+		if err := lk.runStep(ds, m, m.Down, false); err != nil {
+			return fmt.Errorf("migration %d (%s) rollback failed: %w", m.ID, m.Name, err)
+		}
+	}
+	return nil
+}
+
+// MigrationStatus reports which of migrations have been applied to ds and
+// which are still pending, in ascending ID order.
+func MigrationStatus(ds *orm.Datastore, migrations []Migration) (Status, error) {
+	if ds == nil || ds.DB == nil {
+		return Status{}, fmt.Errorf("datastore or database connection was nil")
+	}
+
+	if _, err := ds.DB.Exec(migrationsTableSQL); err != nil {
+		return Status{}, fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	applied, err := appliedVersions(ds.DB)
+	if err != nil {
+		return Status{}, err
+	}
+
+	var status Status
+	for _, m := range sortedByID(migrations) {
+		if applied[m.ID] {
+			status.Applied = append(status.Applied, m)
+		} else {
+			status.Pending = append(status.Pending, m)
+		}
+	}
+	return status, nil
+}
+
+// appliedVersions returns the set of migration IDs already recorded in
+// schema_migrations, read through db (either ds.DB or the lock's held Tx).
+func appliedVersions(db execer) (map[int]bool, error) {
+	rows, err := db.Query("SELECT version FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int]bool)
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			return nil, fmt.Errorf("failed to scan schema_migrations row: %w", err)
+		}
+		applied[version] = true
+	}
+	return applied, rows.Err()
+}
+
+// currentVersion returns the highest version recorded in applied, or 0 if
+// none have run yet.
+func currentVersion(applied map[int]bool) int {
+	max := 0
+	for version := range applied {
+		if version > max {
+			max = version
+		}
+	}
+	return max
+}
+
+// sortedByID returns a copy of migrations sorted in ascending ID order.
+func sortedByID(migrations []Migration) []Migration {
+	sorted := make([]Migration, len(migrations))
+	copy(sorted, migrations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+	return sorted
+}
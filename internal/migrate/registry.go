@@ -0,0 +1,57 @@
+package migrate
+
+import (
+	"database/sql"
+
+	"github.com/pballentine13/liteforge/internal/orm"
+)
+
+// Registry accumulates Migrations via Register and runs them as a set
+// through MigrateUp/MigrateDown/Status, so callers building up a fixed
+// migration set at init time don't have to thread the same []Migration
+// slice through every call to Migrate/MigrateTo/MigrationStatus themselves.
+type Registry struct {
+	migrations []Migration
+}
+
+// NewRegistry returns an empty Registry ready for Register calls.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register adds one versioned, reversible migration to the registry. version
+// must be unique across the registry; down may be nil if the migration is
+// forward-only, but then MigrateDown can't roll it back (see MigrateTo).
+func (r *Registry) Register(version int, name string, up, down func(*sql.Tx) error) {
+	r.migrations = append(r.migrations, Migration{ID: version, Name: name, Up: up, Down: down})
+}
+
+// MigrateUp applies every registered migration with ID <= target that
+// hasn't already run against ds, in ascending order. It is MigrateTo over
+// the registered set, so a target below ds's current version rolls back
+// instead.
+func (r *Registry) MigrateUp(ds *orm.Datastore, target int) error {
+	return MigrateTo(ds, r.migrations, target)
+}
+
+// MigrateDown rolls back every registered migration applied to ds with
+// ID > target, in descending order. It is MigrateTo over the registered set,
+// so a target above ds's current version applies migrations instead.
+func (r *Registry) MigrateDown(ds *orm.Datastore, target int) error {
+	return MigrateTo(ds, r.migrations, target)
+}
+
+// Status reports which registered migrations have applied to ds and which
+// are still pending, in ascending ID order.
+func (r *Registry) Status(ds *orm.Datastore) (Status, error) {
+	return MigrationStatus(ds, r.migrations)
+}
+
+// AutoMigrate reconciles each model's table with ds's live schema - creating
+// missing tables, adding missing columns, and creating tagged indexes. It is
+// a thin convenience wrapper around ds.Migrator().AutoMigrate, kept here so
+// callers already working through the Registry API for versioned migrations
+// have a single import for schema management generally.
+func AutoMigrate(ds *orm.Datastore, models ...any) error {
+	return ds.Migrator().AutoMigrate(models...)
+}
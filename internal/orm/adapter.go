@@ -9,8 +9,9 @@ import (
 	"reflect"
 	"strings"
 
-	_ "github.com/lib/pq"           // For PostgreSQL
-	_ "github.com/mattn/go-sqlite3" // For SQLite
+	_ "github.com/go-sql-driver/mysql" // For MySQL
+	"github.com/lib/pq"                // For PostgreSQL, and its COPY support
+	_ "github.com/mattn/go-sqlite3"    // For SQLite
 )
 
 // DBAdapter defines the interface for database-specific operations.
@@ -20,10 +21,54 @@ type DBAdapter interface {
 	GetPlaceholder(index int) string
 	Query(db *sql.DB, query string, args ...interface{}) (*sql.Rows, error)
 	BeginTx(db *sql.DB) (*sql.Tx, error)
+	// OperatorSQL renders the full comparison expression (using "?" as a
+	// generic placeholder marker) for a QuerySet field-lookup operator such
+	// as "gt" or "icontains" against column, so each adapter can localize
+	// dialect differences (e.g. LIKE vs ILIKE) and, where the comparison
+	// needs it (e.g. Postgres's "iexact"), wrap column itself rather than
+	// just the placeholder.
+	OperatorSQL(op string, column string) string
+	// IntrospectTable reads the live schema for an existing table, so Migrator
+	// can diff it against a model's struct fields. An empty, error-free result
+	// means the table does not exist.
+	IntrospectTable(db *sql.DB, tableName string) ([]ColumnInfo, error)
+	// AlterColumnSQL renders the statement(s) that perform action against col
+	// on tableName. Most actions are a single native ALTER TABLE statement,
+	// but SQLite has no native DROP COLUMN across all supported versions and
+	// instead needs the table-rebuild dance (CREATE new, INSERT SELECT, DROP
+	// old, RENAME), hence the []string return and the db handle to introspect
+	// the rest of the table's columns.
+	AlterColumnSQL(db *sql.DB, tableName string, col ColumnInfo, action ColumnAction) ([]string, error)
+	// QuoteIdent quotes a table or column name in the adapter's dialect, so
+	// that a reserved word (e.g. "order", "user") used as a Go field or
+	// struct name doesn't break the generated SQL.
+	QuoteIdent(name string) string
+	// BulkInsert inserts every row in rows (each in cols order) into table
+	// in as few round trips as the dialect allows, so InsertMany gets an
+	// order-of-magnitude speedup over inserting one row at a time.
+	BulkInsert(db *sql.DB, table string, cols []string, rows [][]any) (sql.Result, error)
+	// Rebind rewrites query's generic "?" placeholders (in order) into the
+	// adapter's own placeholder syntax, e.g. "$1"/"$2" for Postgres or
+	// ":1"/":2" for Oracle. Adapters whose native syntax already is "?"
+	// return query unchanged.
+	Rebind(query string) string
+	// HasReturningID reports whether Insert should append a RETURNING clause
+	// and scan the primary key back from it, rather than call LastInsertId
+	// on the *sql.Result.
+	HasReturningID() bool
+	// AppendReturning appends the adapter's RETURNING-equivalent clause for
+	// pkCol onto query. Only called when HasReturningID is true.
+	AppendReturning(query string, pkCol string) string
 }
 
 // SQLiteAdapter implements the DBAdapter for SQLite.
-type SQLiteAdapter struct{}
+type SQLiteAdapter struct {
+	// MaxBulkParams caps how many "?" placeholders BulkInsert packs into a
+	// single multi-row VALUES statement, chunking across more than one
+	// statement once rows*len(cols) would exceed it. Zero uses SQLite's
+	// default SQLITE_MAX_VARIABLE_NUMBER of 999.
+	MaxBulkParams int
+}
 
 // Connect establishes a SQLite database connection.
 func (a *SQLiteAdapter) Connect(cfg Config) (*sql.DB, error) {
@@ -81,8 +126,23 @@ func (a *SQLiteAdapter) CreateTableSQL(model interface{}) (string, error) {
 		val = val.Elem()
 	}
 	t = val.Type()
+	relations := GetRelations(model)
 	for i := 0; i < val.NumField(); i++ {
 		field := t.Field(i)
+
+		// Relation-typed fields are not real columns: has-many/many-to-many
+		// sides store nothing here, and a belongs-to side emits its FK
+		// column (an INTEGER) instead of a column for the pointer field itself.
+		if rel, ok := relations[field.Name]; ok {
+			switch rel.Kind {
+			case RelationHasMany, RelationManyToMany:
+				continue
+			case RelationBelongsTo:
+				columnDefinitions = append(columnDefinitions, fmt.Sprintf("%s INTEGER", a.QuoteIdent(rel.FKColumn)))
+				continue
+			}
+		}
+
 		columnName := strings.ToLower(field.Name) // Default column name
 
 		// Check for a `db` tag to customize the column name.
@@ -112,11 +172,11 @@ func (a *SQLiteAdapter) CreateTableSQL(model interface{}) (string, error) {
 			sqlType += " PRIMARY KEY"
 		}
 
-		columnDefinitions = append(columnDefinitions, fmt.Sprintf("%s %s %s", columnName, sqlType, columnConstraint))
+		columnDefinitions = append(columnDefinitions, fmt.Sprintf("%s %s %s", a.QuoteIdent(columnName), sqlType, columnConstraint))
 
 	}
 
-	createQuery := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s)", tableName, strings.Join(columnDefinitions, ", "))
+	createQuery := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s)", a.QuoteIdent(tableName), strings.Join(columnDefinitions, ", "))
 	return createQuery, nil
 }
 
@@ -125,6 +185,11 @@ func (a *SQLiteAdapter) GetPlaceholder(index int) string {
 	return "?"
 }
 
+// QuoteIdent quotes name with SQLite's double-quote identifier syntax.
+func (a *SQLiteAdapter) QuoteIdent(name string) string {
+	return `"` + name + `"`
+}
+
 // Query executes a generic query.
 func (a *SQLiteAdapter) Query(db *sql.DB, query string, args ...interface{}) (*sql.Rows, error) {
 	stmt, err := db.Prepare(query)
@@ -150,6 +215,138 @@ func (a *SQLiteAdapter) BeginTx(db *sql.DB) (*sql.Tx, error) {
 	return tx, nil
 }
 
+// OperatorSQL renders the QuerySet lookup expression for SQLite. SQLite's
+// LIKE is case-insensitive by default (for ASCII), so "contains" and
+// "icontains" share the same fragment.
+func (a *SQLiteAdapter) OperatorSQL(op string, column string) string {
+	switch op {
+	case "iexact", "exact":
+		return column + " = ?"
+	case "contains", "icontains":
+		return column + " LIKE ?"
+	case "startswith", "istartswith":
+		return column + " LIKE ?"
+	case "endswith", "iendswith":
+		return column + " LIKE ?"
+	case "gt":
+		return column + " > ?"
+	case "gte":
+		return column + " >= ?"
+	case "lt":
+		return column + " < ?"
+	case "lte":
+		return column + " <= ?"
+	default:
+		return column + " = ?"
+	}
+}
+
+// IntrospectTable reads SQLite's column metadata via PRAGMA table_info. If
+// tableName does not exist, PRAGMA table_info returns zero rows rather than
+// erroring, so the empty, error-free result is how callers detect that.
+func (a *SQLiteAdapter) IntrospectTable(db *sql.DB, tableName string) ([]ColumnInfo, error) {
+	rows, err := db.Query(fmt.Sprintf("PRAGMA table_info(%s)", tableName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to introspect table %q: %w", tableName, err)
+	}
+	defer rows.Close()
+
+	var columns []ColumnInfo
+	for rows.Next() {
+		var cid, notNull, pk int
+		var colName, colType string
+		var dflt sql.NullString
+		if err := rows.Scan(&cid, &colName, &colType, &notNull, &dflt, &pk); err != nil {
+			return nil, fmt.Errorf("failed to scan table_info row: %w", err)
+		}
+		columns = append(columns, ColumnInfo{Name: colName, Type: colType, NotNull: notNull != 0, PK: pk != 0})
+	}
+	return columns, rows.Err()
+}
+
+// AlterColumnSQL renders the ALTER statement(s) for SQLite. ADD COLUMN is
+// native; DROP COLUMN goes through the classic SQLite rebuild dance since
+// not every SQLite build this adapter targets supports DROP COLUMN natively.
+// The rebuilt table does not carry over the dropped column's constraints on
+// the surviving columns beyond what CREATE TABLE ... AS SELECT preserves
+// (i.e. none) - callers needing to preserve PK/NOT NULL across a drop should
+// recreate the table explicitly instead.
+func (a *SQLiteAdapter) AlterColumnSQL(db *sql.DB, tableName string, col ColumnInfo, action ColumnAction) ([]string, error) {
+	switch action {
+	case ColumnActionAdd:
+		return []string{fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", tableName, col.Name, sqliteGoTypeToSQL(col.Type))}, nil
+	case ColumnActionDrop:
+		return a.dropColumnStatements(db, tableName, col.Name)
+	default:
+		return nil, fmt.Errorf("unsupported column action: %v", action)
+	}
+}
+
+// dropColumnStatements builds the CREATE/INSERT/DROP/RENAME sequence that
+// drops colName from tableName by rebuilding the table without it.
+func (a *SQLiteAdapter) dropColumnStatements(db *sql.DB, tableName, colName string) ([]string, error) {
+	existing, err := a.IntrospectTable(db, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to introspect table %q for column drop: %w", tableName, err)
+	}
+
+	keep := make([]string, 0, len(existing))
+	for _, c := range existing {
+		if strings.EqualFold(c.Name, colName) {
+			continue
+		}
+		keep = append(keep, c.Name)
+	}
+	if len(keep) == len(existing) {
+		return nil, fmt.Errorf("column %q does not exist on table %q", colName, tableName)
+	}
+
+	tmpTable := tableName + "_liteforge_tmp"
+	colList := strings.Join(keep, ", ")
+
+	return []string{
+		fmt.Sprintf("CREATE TABLE %s AS SELECT %s FROM %s WHERE 0", tmpTable, colList, tableName),
+		fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s", tmpTable, colList, colList, tableName),
+		fmt.Sprintf("DROP TABLE %s", tableName),
+		fmt.Sprintf("ALTER TABLE %s RENAME TO %s", tmpTable, tableName),
+	}, nil
+}
+
+// BulkInsert emits a single "INSERT INTO t (cols...) VALUES (...),(...)"
+// statement per chunk of rows, chunked so each statement stays under
+// MaxBulkParams placeholders (SQLite's SQLITE_MAX_VARIABLE_NUMBER by
+// default), and returns the combined rows-affected count across chunks.
+func (a *SQLiteAdapter) BulkInsert(db *sql.DB, table string, cols []string, rows [][]any) (sql.Result, error) {
+	return multiRowValuesInsert(a, db, table, cols, rows, a.MaxBulkParams, sqliteDefaultMaxBulkParams)
+}
+
+// Rebind is a no-op: SQLite already takes "?" placeholders.
+func (a *SQLiteAdapter) Rebind(query string) string { return query }
+
+// HasReturningID is false: SQLite populates the inserted ID through
+// sql.Result.LastInsertId instead.
+func (a *SQLiteAdapter) HasReturningID() bool { return false }
+
+// AppendReturning is never called since HasReturningID is false.
+func (a *SQLiteAdapter) AppendReturning(query string, pkCol string) string { return query }
+
+// sqliteGoTypeToSQL maps a Go field type (as rendered by reflect.Type.String)
+// to its SQLite column type, mirroring CreateTableSQL's inline switch.
+func sqliteGoTypeToSQL(goType string) string {
+	switch goType {
+	case "int", "int64", "int32", "int16", "int8":
+		return "INTEGER"
+	case "string":
+		return "TEXT"
+	case "float64", "float32":
+		return "REAL"
+	case "bool":
+		return "BOOLEAN"
+	default:
+		return "TEXT"
+	}
+}
+
 // PostgresAdapter implements the DBAdapter for PostgreSQL.
 type PostgresAdapter struct{}
 
@@ -190,8 +387,23 @@ func (a *PostgresAdapter) CreateTableSQL(model interface{}) (string, error) {
 		val = val.Elem()
 	}
 	t = val.Type()
+	relations := GetRelations(model)
 	for i := 0; i < val.NumField(); i++ {
 		field := t.Field(i)
+
+		// Relation-typed fields are not real columns: has-many/many-to-many
+		// sides store nothing here, and a belongs-to side emits its FK
+		// column (an INTEGER) instead of a column for the pointer field itself.
+		if rel, ok := relations[field.Name]; ok {
+			switch rel.Kind {
+			case RelationHasMany, RelationManyToMany:
+				continue
+			case RelationBelongsTo:
+				columnDefinitions = append(columnDefinitions, fmt.Sprintf("%s INTEGER", a.QuoteIdent(rel.FKColumn)))
+				continue
+			}
+		}
+
 		columnName := strings.ToLower(field.Name) // Default column name
 
 		// Check for a `db` tag to customize the column name.
@@ -225,11 +437,11 @@ func (a *PostgresAdapter) CreateTableSQL(model interface{}) (string, error) {
 			}
 		}
 
-		columnDefinitions = append(columnDefinitions, fmt.Sprintf("%s %s %s", columnName, sqlType, columnConstraint))
+		columnDefinitions = append(columnDefinitions, fmt.Sprintf("%s %s %s", a.QuoteIdent(columnName), sqlType, columnConstraint))
 
 	}
 
-	createQuery := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s)", tableName, strings.Join(columnDefinitions, ", "))
+	createQuery := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s)", a.QuoteIdent(tableName), strings.Join(columnDefinitions, ", "))
 	return createQuery, nil
 }
 
@@ -238,6 +450,11 @@ func (a *PostgresAdapter) GetPlaceholder(index int) string {
 	return fmt.Sprintf("$%d", index)
 }
 
+// QuoteIdent quotes name with Postgres's double-quote identifier syntax.
+func (a *PostgresAdapter) QuoteIdent(name string) string {
+	return `"` + name + `"`
+}
+
 // Query executes a generic query.
 func (a *PostgresAdapter) Query(db *sql.DB, query string, args ...interface{}) (*sql.Rows, error) {
 	stmt, err := db.Prepare(query)
@@ -262,3 +479,440 @@ func (a *PostgresAdapter) BeginTx(db *sql.DB) (*sql.Tx, error) {
 	}
 	return tx, nil
 }
+
+// OperatorSQL renders the QuerySet lookup expression for PostgreSQL.
+// Postgres distinguishes case-sensitive LIKE from case-insensitive ILIKE, so
+// the "i"-prefixed lookups get their own fragments; "iexact" compares both
+// sides via UPPER() since Postgres has no case-insensitive "=".
+func (a *PostgresAdapter) OperatorSQL(op string, column string) string {
+	switch op {
+	case "exact":
+		return column + " = ?"
+	case "iexact":
+		return "UPPER(" + column + ") = UPPER(?)"
+	case "contains":
+		return column + " LIKE ?"
+	case "icontains":
+		return column + " ILIKE ?"
+	case "startswith":
+		return column + " LIKE ?"
+	case "istartswith":
+		return column + " ILIKE ?"
+	case "endswith":
+		return column + " LIKE ?"
+	case "iendswith":
+		return column + " ILIKE ?"
+	case "gt":
+		return column + " > ?"
+	case "gte":
+		return column + " >= ?"
+	case "lt":
+		return column + " < ?"
+	case "lte":
+		return column + " <= ?"
+	default:
+		return column + " = ?"
+	}
+}
+
+// IntrospectTable reads Postgres's column metadata from
+// information_schema.columns. If tableName does not exist, the query
+// returns zero rows rather than erroring, so the empty, error-free result
+// is how callers detect that. Primary-key detection is left to the SQLite
+// adapter (which reads it straight off PRAGMA table_info); Migrator only
+// needs column presence to diff a struct against the live schema.
+func (a *PostgresAdapter) IntrospectTable(db *sql.DB, tableName string) ([]ColumnInfo, error) {
+	rows, err := db.Query(
+		"SELECT column_name, data_type, is_nullable FROM information_schema.columns WHERE table_name = $1",
+		tableName,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to introspect table %q: %w", tableName, err)
+	}
+	defer rows.Close()
+
+	var columns []ColumnInfo
+	for rows.Next() {
+		var colName, dataType, isNullable string
+		if err := rows.Scan(&colName, &dataType, &isNullable); err != nil {
+			return nil, fmt.Errorf("failed to scan information_schema row: %w", err)
+		}
+		columns = append(columns, ColumnInfo{Name: colName, Type: dataType, NotNull: isNullable == "NO"})
+	}
+	return columns, rows.Err()
+}
+
+// AlterColumnSQL renders the ALTER statement for Postgres. Unlike SQLite,
+// Postgres supports native ADD COLUMN and DROP COLUMN, so both actions are
+// a single statement.
+func (a *PostgresAdapter) AlterColumnSQL(db *sql.DB, tableName string, col ColumnInfo, action ColumnAction) ([]string, error) {
+	switch action {
+	case ColumnActionAdd:
+		return []string{fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", tableName, col.Name, postgresGoTypeToSQL(col.Type))}, nil
+	case ColumnActionDrop:
+		return []string{fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", tableName, col.Name)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported column action: %v", action)
+	}
+}
+
+// BulkInsert streams rows into table via Postgres's COPY FROM STDIN
+// protocol (pq.CopyIn), which avoids both the per-row round trip and the
+// parameter-count limits a VALUES list runs into - the whole point of
+// choosing it over the other adapters' chunked-INSERT approach. All rows
+// are streamed inside one transaction, so a failure partway through leaves
+// none of them committed.
+func (a *PostgresAdapter) BulkInsert(db *sql.DB, table string, cols []string, rows [][]any) (sql.Result, error) {
+	if len(rows) == 0 {
+		return bulkResult{}, nil
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin bulk insert transaction: %w", err)
+	}
+
+	stmt, err := tx.Prepare(pq.CopyIn(table, cols...))
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to prepare COPY statement: %w", err)
+	}
+
+	for _, row := range rows {
+		if _, err := stmt.Exec(row...); err != nil {
+			stmt.Close()
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to stream bulk insert row: %w", err)
+		}
+	}
+
+	if _, err := stmt.Exec(); err != nil {
+		stmt.Close()
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to flush COPY statement: %w", err)
+	}
+	if err := stmt.Close(); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to close COPY statement: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit bulk insert transaction: %w", err)
+	}
+
+	return bulkResult{rowsAffected: int64(len(rows))}, nil
+}
+
+// Rebind rewrites "?" placeholders into Postgres's "$1", "$2", ... syntax.
+func (a *PostgresAdapter) Rebind(query string) string {
+	return rebindPositional(query, "$")
+}
+
+// HasReturningID is true: Postgres has no LastInsertId support, so Insert
+// appends RETURNING and scans the primary key back from it instead.
+func (a *PostgresAdapter) HasReturningID() bool { return true }
+
+// AppendReturning appends a RETURNING clause for pkCol.
+func (a *PostgresAdapter) AppendReturning(query string, pkCol string) string {
+	return fmt.Sprintf("%s RETURNING %s", query, a.QuoteIdent(pkCol))
+}
+
+// postgresGoTypeToSQL maps a Go field type (as rendered by reflect.Type.String)
+// to its Postgres column type, mirroring CreateTableSQL's inline switch.
+func postgresGoTypeToSQL(goType string) string {
+	switch goType {
+	case "int", "int64", "int32", "int16", "int8":
+		return "INTEGER"
+	case "string":
+		return "TEXT"
+	case "float64", "float32":
+		return "DOUBLE PRECISION"
+	case "bool":
+		return "BOOLEAN"
+	default:
+		return "TEXT"
+	}
+}
+
+// MySQLAdapter implements the DBAdapter for MySQL.
+type MySQLAdapter struct {
+	// MaxBulkParams caps how many "?" placeholders BulkInsert packs into a
+	// single multi-row VALUES statement, mirroring SQLiteAdapter.MaxBulkParams.
+	// Zero uses mysqlDefaultMaxBulkParams.
+	MaxBulkParams int
+}
+
+// Connect establishes a MySQL database connection, augmenting the DSN with
+// cfg.CharsetCollation when set.
+func (a *MySQLAdapter) Connect(cfg Config) (*sql.DB, error) {
+	db, err := sql.Open("mysql", mysqlDSNWithCharset(cfg.DataSourceName, cfg.CharsetCollation))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	// Test the connection
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+	return db, nil
+}
+
+// mysqlDSNWithCharset appends charsetCollation (a query-string fragment such
+// as "charset=utf8mb4&collation=utf8mb4_unicode_ci") to dsn, joining it with
+// "?" or "&" depending on whether dsn already has query parameters.
+func mysqlDSNWithCharset(dsn, charsetCollation string) string {
+	if charsetCollation == "" {
+		return dsn
+	}
+	if strings.Contains(dsn, "?") {
+		return dsn + "&" + charsetCollation
+	}
+	return dsn + "?" + charsetCollation
+}
+
+// mysqlNeedsVarchar reports whether a string field must be declared
+// VARCHAR(255) rather than TEXT: MySQL can only index a bounded-length
+// column, so a primary key, a `db:"...unique"` tag, or a
+// `liteforge:"index"`/`liteforge:"unique"` tag all require it.
+func mysqlNeedsVarchar(field reflect.StructField) bool {
+	if field.Tag.Get("pk") == "true" {
+		return true
+	}
+	if strings.Contains(strings.ToLower(field.Tag.Get("db")), "unique") {
+		return true
+	}
+	liteforgeTag := field.Tag.Get("liteforge")
+	return strings.Contains(liteforgeTag, "index") || strings.Contains(liteforgeTag, "unique")
+}
+
+// CreateTableSQL generates the MySQL-specific CREATE TABLE SQL statement.
+func (a *MySQLAdapter) CreateTableSQL(model interface{}) (string, error) {
+	// Check for invalid inputs
+	if model == nil {
+		return "", errors.New("no model passed in. model was nil")
+	}
+
+	t := reflect.TypeOf(model)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return "", errors.New("model must be a struct or pointer to struct")
+	}
+	tableName := GetTableName(model)
+
+	var columnDefinitions []string
+	var columnConstraint string
+
+	val := reflect.ValueOf(model)
+	if val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+	t = val.Type()
+	relations := GetRelations(model)
+	for i := 0; i < val.NumField(); i++ {
+		field := t.Field(i)
+
+		// Relation-typed fields are not real columns: has-many/many-to-many
+		// sides store nothing here, and a belongs-to side emits its FK
+		// column (an INT) instead of a column for the pointer field itself.
+		if rel, ok := relations[field.Name]; ok {
+			switch rel.Kind {
+			case RelationHasMany, RelationManyToMany:
+				continue
+			case RelationBelongsTo:
+				columnDefinitions = append(columnDefinitions, fmt.Sprintf("%s INT", a.QuoteIdent(rel.FKColumn)))
+				continue
+			}
+		}
+
+		columnName := strings.ToLower(field.Name) // Default column name
+
+		// Check for a `db` tag to customize the column name.
+		columnConstraint = ""
+		dbTag := field.Tag.Get("db")
+		if dbTag != "" {
+			columnConstraint = strings.ToUpper(dbTag)
+		}
+		fieldType := field.Type.String()
+		sqlType := ""
+
+		switch fieldType {
+		case "int", "int64", "int32", "int16", "int8":
+			sqlType = "INT"
+		case "string":
+			if mysqlNeedsVarchar(field) {
+				sqlType = "VARCHAR(255)"
+			} else {
+				sqlType = "TEXT"
+			}
+		case "float64", "float32":
+			sqlType = "DOUBLE"
+		case "bool":
+			sqlType = "TINYINT(1)"
+		default:
+			sqlType = "TEXT" // Default to TEXT if type is unknown
+		}
+		// Check for primary key tag
+		pkTag := field.Tag.Get("pk")
+		if pkTag == "true" {
+			if sqlType == "INT" {
+				sqlType += " AUTO_INCREMENT PRIMARY KEY"
+			} else {
+				sqlType += " PRIMARY KEY"
+			}
+		}
+
+		columnDefinitions = append(columnDefinitions, fmt.Sprintf("%s %s %s", a.QuoteIdent(columnName), sqlType, columnConstraint))
+
+	}
+
+	createQuery := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+		a.QuoteIdent(tableName), strings.Join(columnDefinitions, ", "))
+	return createQuery, nil
+}
+
+// GetPlaceholder returns the MySQL placeholder '?'.
+func (a *MySQLAdapter) GetPlaceholder(index int) string {
+	return "?"
+}
+
+// QuoteIdent quotes name with MySQL's backtick identifier syntax.
+func (a *MySQLAdapter) QuoteIdent(name string) string {
+	return "`" + name + "`"
+}
+
+// Query executes a generic query.
+func (a *MySQLAdapter) Query(db *sql.DB, query string, args ...interface{}) (*sql.Rows, error) {
+	stmt, err := db.Prepare(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare query: %w", err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.Query(args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return rows, nil
+}
+
+// BeginTx starts a database transaction.
+func (a *MySQLAdapter) BeginTx(db *sql.DB) (*sql.Tx, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	return tx, nil
+}
+
+// OperatorSQL renders the QuerySet lookup expression for MySQL. MySQL's LIKE
+// is case-insensitive by default for the common collations, so "contains"
+// and "icontains" (and the other "i"-prefixed lookups) share the same
+// fragment as their case-sensitive counterparts.
+func (a *MySQLAdapter) OperatorSQL(op string, column string) string {
+	switch op {
+	case "iexact", "exact":
+		return column + " = ?"
+	case "contains", "icontains":
+		return column + " LIKE ?"
+	case "startswith", "istartswith":
+		return column + " LIKE ?"
+	case "endswith", "iendswith":
+		return column + " LIKE ?"
+	case "gt":
+		return column + " > ?"
+	case "gte":
+		return column + " >= ?"
+	case "lt":
+		return column + " < ?"
+	case "lte":
+		return column + " <= ?"
+	default:
+		return column + " = ?"
+	}
+}
+
+// IntrospectTable reads MySQL's column metadata from
+// information_schema.columns, scoped to the current database. If tableName
+// does not exist, the query returns zero rows rather than erroring, so the
+// empty, error-free result is how callers detect that.
+func (a *MySQLAdapter) IntrospectTable(db *sql.DB, tableName string) ([]ColumnInfo, error) {
+	rows, err := db.Query(
+		"SELECT column_name, data_type, is_nullable, column_key FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = ?",
+		tableName,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to introspect table %q: %w", tableName, err)
+	}
+	defer rows.Close()
+
+	var columns []ColumnInfo
+	for rows.Next() {
+		var colName, dataType, isNullable, columnKey string
+		if err := rows.Scan(&colName, &dataType, &isNullable, &columnKey); err != nil {
+			return nil, fmt.Errorf("failed to scan information_schema row: %w", err)
+		}
+		columns = append(columns, ColumnInfo{
+			Name:    colName,
+			Type:    dataType,
+			NotNull: isNullable == "NO",
+			PK:      columnKey == "PRI",
+		})
+	}
+	return columns, rows.Err()
+}
+
+// AlterColumnSQL renders the ALTER statement for MySQL. Like Postgres, MySQL
+// supports native ADD COLUMN and DROP COLUMN, so both actions are a single
+// statement.
+func (a *MySQLAdapter) AlterColumnSQL(db *sql.DB, tableName string, col ColumnInfo, action ColumnAction) ([]string, error) {
+	switch action {
+	case ColumnActionAdd:
+		return []string{fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", a.QuoteIdent(tableName), a.QuoteIdent(col.Name), mysqlGoTypeToSQL(col.Type))}, nil
+	case ColumnActionDrop:
+		return []string{fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", a.QuoteIdent(tableName), a.QuoteIdent(col.Name))}, nil
+	default:
+		return nil, fmt.Errorf("unsupported column action: %v", action)
+	}
+}
+
+// BulkInsert emits a single multi-row VALUES statement per chunk, the same
+// way SQLiteAdapter.BulkInsert does - MySQL's actual limit is on packet
+// size rather than parameter count, but chunking by MaxBulkParams keeps
+// both adapters' behavior easy to reason about together.
+func (a *MySQLAdapter) BulkInsert(db *sql.DB, table string, cols []string, rows [][]any) (sql.Result, error) {
+	return multiRowValuesInsert(a, db, table, cols, rows, a.MaxBulkParams, mysqlDefaultMaxBulkParams)
+}
+
+// Rebind is a no-op: MySQL already takes "?" placeholders.
+func (a *MySQLAdapter) Rebind(query string) string { return query }
+
+// HasReturningID is false: MySQL populates the inserted ID through
+// sql.Result.LastInsertId instead.
+func (a *MySQLAdapter) HasReturningID() bool { return false }
+
+// AppendReturning is never called since HasReturningID is false.
+func (a *MySQLAdapter) AppendReturning(query string, pkCol string) string { return query }
+
+// mysqlGoTypeToSQL maps a Go field type (as rendered by reflect.Type.String)
+// to its MySQL column type, mirroring CreateTableSQL's inline switch. It
+// always picks TEXT for strings since, unlike CreateTableSQL, it has no
+// struct tag to decide whether the new column needs to be indexable, so
+// every string column added through AlterColumnSQL (including the ones
+// AutoMigrate adds) is TEXT, never VARCHAR(255).
+func mysqlGoTypeToSQL(goType string) string {
+	switch goType {
+	case "int", "int64", "int32", "int16", "int8":
+		return "INT"
+	case "string":
+		return "TEXT"
+	case "float64", "float32":
+		return "DOUBLE"
+	case "bool":
+		return "TINYINT(1)"
+	default:
+		return "TEXT"
+	}
+}
+
+var _ DBAdapter = (*MySQLAdapter)(nil)
@@ -0,0 +1,316 @@
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// defaultBatchChunkSize is used by InsertMulti/UpdateMulti/DeleteMulti when
+// chunkSize is <= 0.
+const defaultBatchChunkSize = 500
+
+// batchChunkRows bounds how many rows a single multi-row statement packs in,
+// so a generated INSERT/DELETE stays under SQLite's default
+// SQLITE_MAX_VARIABLE_NUMBER (999 bind parameters) regardless of what
+// chunkSize the caller asked for - the same conservative ceiling is applied
+// to every dialect here since InsertMulti/DeleteMulti build one shared SQL
+// shape rather than routing through each adapter's own BulkInsert.
+func batchChunkRows(chunkSize, paramsPerRow int) int {
+	rows := chunkSize
+	if rows <= 0 {
+		rows = defaultBatchChunkSize
+	}
+	if paramsPerRow < 1 {
+		paramsPerRow = 1
+	}
+	if max := sqliteDefaultMaxBulkParams / paramsPerRow; max >= 1 && rows > max {
+		rows = max
+	}
+	return rows
+}
+
+// InsertMulti inserts every element of models - a slice of structs or struct
+// pointers, all of the same model type - as chunked multi-row "INSERT INTO
+// t (cols) VALUES (...),(...)" statements run inside a single transaction,
+// chunked to stay under SQLite's 999-parameter limit regardless of
+// chunkSize. chunkSize <= 0 uses a default of 500 rows per statement.
+func InsertMulti(ds *Datastore, models any, chunkSize int) (sql.Result, error) {
+	if ds == nil || ds.DB == nil || ds.Adapter == nil {
+		return nil, fmt.Errorf("datastore, database connection, or adapter was nil")
+	}
+
+	val := reflect.ValueOf(models)
+	if val.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("models must be a slice of structs")
+	}
+	if val.Len() == 0 {
+		return bulkResult{}, nil
+	}
+
+	first := val.Index(0).Interface()
+	tableName := GetTableName(first)
+	pkCol, err := GetPrimaryKeyColumn(first)
+	if err != nil {
+		pkCol = ""
+	}
+
+	allColumns, _ := GetFieldInfo(first)
+	columns := make([]string, 0, len(allColumns))
+	for _, col := range allColumns {
+		if col != pkCol {
+			columns = append(columns, col)
+		}
+	}
+
+	rows := make([][]any, 0, val.Len())
+	for i := 0; i < val.Len(); i++ {
+		rowColumns, rowValues := GetFieldInfo(val.Index(i).Interface())
+		row := make([]any, 0, len(columns))
+		for j, col := range rowColumns {
+			if col != pkCol {
+				row = append(row, rowValues[j])
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	quotedCols := make([]string, len(columns))
+	for i, col := range columns {
+		quotedCols[i] = ds.Adapter.QuoteIdent(col)
+	}
+
+	tx, err := ds.Adapter.BeginTx(ds.DB)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin InsertMulti transaction: %w", err)
+	}
+
+	rowsPerChunk := batchChunkRows(chunkSize, len(columns))
+	var totalAffected int64
+	label := fmt.Sprintf("BATCH INSERT INTO %s (%d rows)", tableName, len(rows))
+	err = instrument(context.Background(), ds, label, nil, func() error {
+		for start := 0; start < len(rows); start += rowsPerChunk {
+			end := start + rowsPerChunk
+			if end > len(rows) {
+				end = len(rows)
+			}
+			chunk := rows[start:end]
+
+			placeholderGroups := make([]string, len(chunk))
+			args := make([]any, 0, len(chunk)*len(columns))
+			argIndex := 1
+			for i, row := range chunk {
+				placeholders := make([]string, len(columns))
+				for j := range columns {
+					placeholders[j] = ds.Adapter.GetPlaceholder(argIndex)
+					argIndex++
+				}
+				placeholderGroups[i] = "(" + strings.Join(placeholders, ", ") + ")"
+				args = append(args, row...)
+			}
+
+			query := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s",
+				ds.Adapter.QuoteIdent(tableName), strings.Join(quotedCols, ", "), strings.Join(placeholderGroups, ", "))
+
+			result, err := tx.Exec(query, args...)
+			if err != nil {
+				return fmt.Errorf("failed to execute InsertMulti chunk: %w", err)
+			}
+			if affected, err := result.RowsAffected(); err == nil {
+				totalAffected += affected
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit InsertMulti transaction: %w", err)
+	}
+	return bulkResult{rowsAffected: totalAffected}, nil
+}
+
+// UpdateMulti updates every element of models - a slice of structs or struct
+// pointers sharing a primary key column - by running one UPDATE per row
+// against a prepared statement, committed in chunks of chunkSize rows (at
+// most 999, as batchChunkRows caps it) so a large slice doesn't hold one giant
+// transaction open. chunkSize <= 0 uses a default of 500 rows per transaction.
+func UpdateMulti(ds *Datastore, models any, chunkSize int) (sql.Result, error) {
+	if ds == nil || ds.DB == nil || ds.Adapter == nil {
+		return nil, fmt.Errorf("datastore, database connection, or adapter was nil")
+	}
+
+	val := reflect.ValueOf(models)
+	if val.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("models must be a slice of structs")
+	}
+	if val.Len() == 0 {
+		return bulkResult{}, nil
+	}
+
+	first := val.Index(0).Interface()
+	tableName := GetTableName(first)
+	pkCol, err := GetPrimaryKeyColumn(first)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateMulti requires a model with a primary key: %w", err)
+	}
+
+	allColumns, _ := GetFieldInfo(first)
+	setColumns := make([]string, 0, len(allColumns))
+	for _, col := range allColumns {
+		if col != pkCol {
+			setColumns = append(setColumns, col)
+		}
+	}
+
+	setClauses := make([]string, len(setColumns))
+	for i, col := range setColumns {
+		setClauses[i] = fmt.Sprintf("%s = %s", ds.Adapter.QuoteIdent(col), ds.Adapter.GetPlaceholder(i+1))
+	}
+	query := fmt.Sprintf("UPDATE %s SET %s WHERE %s = %s",
+		ds.Adapter.QuoteIdent(tableName), strings.Join(setClauses, ", "),
+		ds.Adapter.QuoteIdent(pkCol), ds.Adapter.GetPlaceholder(len(setColumns)+1))
+
+	rowsPerChunk := batchChunkRows(chunkSize, 1)
+	var totalAffected int64
+	label := fmt.Sprintf("BATCH UPDATE %s (%d rows)", tableName, val.Len())
+	err = instrument(context.Background(), ds, label, nil, func() error {
+		for start := 0; start < val.Len(); start += rowsPerChunk {
+			end := start + rowsPerChunk
+			if end > val.Len() {
+				end = val.Len()
+			}
+
+			tx, err := ds.Adapter.BeginTx(ds.DB)
+			if err != nil {
+				return fmt.Errorf("failed to begin UpdateMulti transaction: %w", err)
+			}
+
+			stmt, err := tx.Prepare(query)
+			if err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to prepare UpdateMulti statement: %w", err)
+			}
+
+			for i := start; i < end; i++ {
+				columns, values := GetFieldInfo(val.Index(i).Interface())
+				args := make([]any, 0, len(setColumns)+1)
+				var pkValue any
+				for j, col := range columns {
+					if col == pkCol {
+						pkValue = values[j]
+						continue
+					}
+					args = append(args, values[j])
+				}
+				args = append(args, pkValue)
+
+				result, err := stmt.Exec(args...)
+				if err != nil {
+					stmt.Close()
+					tx.Rollback()
+					return fmt.Errorf("failed to execute UpdateMulti row: %w", err)
+				}
+				if affected, err := result.RowsAffected(); err == nil {
+					totalAffected += affected
+				}
+			}
+
+			if err := stmt.Close(); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to close UpdateMulti statement: %w", err)
+			}
+			if err := tx.Commit(); err != nil {
+				return fmt.Errorf("failed to commit UpdateMulti transaction: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bulkResult{rowsAffected: totalAffected}, nil
+}
+
+// DeleteMulti deletes every element of models - a slice of structs or struct
+// pointers sharing a primary key column - as chunked "DELETE FROM t WHERE pk
+// IN (?,?,...)" statements run inside a single transaction, chunked to stay
+// under SQLite's 999-parameter limit regardless of chunkSize. chunkSize <= 0
+// uses a default of 500 rows per statement.
+func DeleteMulti(ds *Datastore, models any, chunkSize int) (sql.Result, error) {
+	if ds == nil || ds.DB == nil || ds.Adapter == nil {
+		return nil, fmt.Errorf("datastore, database connection, or adapter was nil")
+	}
+
+	val := reflect.ValueOf(models)
+	if val.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("models must be a slice of structs")
+	}
+	if val.Len() == 0 {
+		return bulkResult{}, nil
+	}
+
+	first := val.Index(0).Interface()
+	tableName := GetTableName(first)
+	pkCol, err := GetPrimaryKeyColumn(first)
+	if err != nil {
+		return nil, fmt.Errorf("DeleteMulti requires a model with a primary key: %w", err)
+	}
+
+	pkValues := make([]any, val.Len())
+	for i := 0; i < val.Len(); i++ {
+		pkValues[i], err = GetPrimaryKeyValue(val.Index(i).Interface())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read primary key for row %d: %w", i, err)
+		}
+	}
+
+	tx, err := ds.Adapter.BeginTx(ds.DB)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin DeleteMulti transaction: %w", err)
+	}
+
+	rowsPerChunk := batchChunkRows(chunkSize, 1)
+	var totalAffected int64
+	label := fmt.Sprintf("BATCH DELETE FROM %s (%d rows)", tableName, len(pkValues))
+	err = instrument(context.Background(), ds, label, nil, func() error {
+		for start := 0; start < len(pkValues); start += rowsPerChunk {
+			end := start + rowsPerChunk
+			if end > len(pkValues) {
+				end = len(pkValues)
+			}
+			chunk := pkValues[start:end]
+
+			placeholders := make([]string, len(chunk))
+			for i := range chunk {
+				placeholders[i] = ds.Adapter.GetPlaceholder(i + 1)
+			}
+
+			query := fmt.Sprintf("DELETE FROM %s WHERE %s IN (%s)",
+				ds.Adapter.QuoteIdent(tableName), ds.Adapter.QuoteIdent(pkCol), strings.Join(placeholders, ", "))
+
+			result, err := tx.Exec(query, chunk...)
+			if err != nil {
+				return fmt.Errorf("failed to execute DeleteMulti chunk: %w", err)
+			}
+			if affected, err := result.RowsAffected(); err == nil {
+				totalAffected += affected
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit DeleteMulti transaction: %w", err)
+	}
+	return bulkResult{rowsAffected: totalAffected}, nil
+}
@@ -0,0 +1,152 @@
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// sqliteDefaultMaxBulkParams mirrors SQLite's default
+// SQLITE_MAX_VARIABLE_NUMBER, the ceiling on "?" placeholders in one
+// statement that a multi-row VALUES insert must stay under.
+const sqliteDefaultMaxBulkParams = 999
+
+// mysqlDefaultMaxBulkParams has no equivalent hard limit in MySQL itself
+// (its real constraint is max_allowed_packet), but chunking by the same
+// order of magnitude as SQLite keeps a single generated statement small
+// enough to stay well under that in practice.
+const mysqlDefaultMaxBulkParams = 1000
+
+// bulkResult is the sql.Result BulkInsert returns for adapters that don't
+// (or, across a chunked multi-row insert, can't meaningfully) report a
+// single last-inserted ID.
+type bulkResult struct {
+	rowsAffected int64
+}
+
+func (r bulkResult) LastInsertId() (int64, error) {
+	return 0, fmt.Errorf("liteforge: LastInsertId is not supported for bulk inserts")
+}
+
+func (r bulkResult) RowsAffected() (int64, error) {
+	return r.rowsAffected, nil
+}
+
+// multiRowValuesInsert is the chunked "INSERT INTO t (cols...) VALUES
+// (...),(...)" path shared by SQLiteAdapter and MySQLAdapter's BulkInsert:
+// both dialects support a multi-row VALUES list natively, so the only
+// thing that differs between them is identifier/placeholder syntax
+// (handled via adapter) and the default chunk ceiling.
+func multiRowValuesInsert(adapter DBAdapter, db *sql.DB, table string, cols []string, rows [][]any, maxParams, defaultMaxParams int) (sql.Result, error) {
+	if len(rows) == 0 {
+		return bulkResult{}, nil
+	}
+
+	limit := maxParams
+	if limit <= 0 {
+		limit = defaultMaxParams
+	}
+	chunkRows := limit / len(cols)
+	if chunkRows < 1 {
+		chunkRows = 1
+	}
+
+	quotedCols := make([]string, len(cols))
+	for i, col := range cols {
+		quotedCols[i] = adapter.QuoteIdent(col)
+	}
+
+	var totalAffected int64
+	for start := 0; start < len(rows); start += chunkRows {
+		end := start + chunkRows
+		if end > len(rows) {
+			end = len(rows)
+		}
+		chunk := rows[start:end]
+
+		placeholderGroups := make([]string, len(chunk))
+		args := make([]any, 0, len(chunk)*len(cols))
+		argIndex := 1
+		for i, row := range chunk {
+			placeholders := make([]string, len(cols))
+			for j := range cols {
+				placeholders[j] = adapter.GetPlaceholder(argIndex)
+				argIndex++
+			}
+			placeholderGroups[i] = "(" + strings.Join(placeholders, ", ") + ")"
+			args = append(args, row...)
+		}
+
+		query := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s",
+			adapter.QuoteIdent(table), strings.Join(quotedCols, ", "), strings.Join(placeholderGroups, ", "))
+
+		result, err := db.Exec(query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute bulk insert chunk: %w", err)
+		}
+		if affected, err := result.RowsAffected(); err == nil {
+			totalAffected += affected
+		}
+	}
+	return bulkResult{rowsAffected: totalAffected}, nil
+}
+
+// InsertMany bulk-inserts every element of models - a slice of structs or
+// struct pointers, all of the same model type - via the Datastore's
+// adapter-specific BulkInsert, giving callers an order-of-magnitude
+// speedup over inserting one row at a time with Insert for seed data and
+// ETL-style loads.
+func InsertMany(ds *Datastore, models any) (sql.Result, error) {
+	if ds == nil || ds.DB == nil || ds.Adapter == nil {
+		return nil, fmt.Errorf("datastore, database connection, or adapter was nil")
+	}
+
+	val := reflect.ValueOf(models)
+	if val.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("models must be a slice of structs")
+	}
+	if val.Len() == 0 {
+		return bulkResult{}, nil
+	}
+
+	first := val.Index(0).Interface()
+	tableName := GetTableName(first)
+
+	pkCol, err := GetPrimaryKeyColumn(first)
+	if err != nil {
+		pkCol = ""
+	}
+
+	allColumns, _ := GetFieldInfo(first)
+	columns := make([]string, 0, len(allColumns))
+	for _, col := range allColumns {
+		if col == pkCol {
+			continue // Skip primary key for auto-increment
+		}
+		columns = append(columns, col)
+	}
+
+	rows := make([][]any, 0, val.Len())
+	for i := 0; i < val.Len(); i++ {
+		rowColumns, rowValues := GetFieldInfo(val.Index(i).Interface())
+		row := make([]any, 0, len(columns))
+		for j, col := range rowColumns {
+			if col == pkCol {
+				continue
+			}
+			row = append(row, rowValues[j])
+		}
+		rows = append(rows, row)
+	}
+
+	var result sql.Result
+	label := fmt.Sprintf("BULK INSERT INTO %s (%d rows)", tableName, len(rows))
+	err = instrument(context.Background(), ds, label, nil, func() error {
+		var err error
+		result, err = ds.Adapter.BulkInsert(ds.DB, tableName, columns, rows)
+		return err
+	})
+	return result, err
+}
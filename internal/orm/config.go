@@ -2,6 +2,7 @@ package orm
 
 import (
 	"fmt"
+	"time"
 )
 
 // Config holds the configuration options for the Liteforge database connection.
@@ -11,6 +12,47 @@ type Config struct {
 	UseWriteAheadLogs bool   // Whether to enable Write Ahead Logs for sqlite
 	EncryptAtRest     bool   // Whether to enable encryption at rest (SQLCipher for SQLite).
 	EncryptionKey     string // The encryption key (if EncryptAtRest is true).  SHOULD NOT BE HARDCODED.
+
+	// CharsetCollation augments a MySQL DataSourceName with a charset/collation
+	// query-string fragment, e.g. "charset=utf8mb4&collation=utf8mb4_unicode_ci".
+	// Ignored by every other driver.
+	CharsetCollation string
+
+	// Connection holds structured connection settings (host, port,
+	// credentials, TLS) for callers who'd rather not hand-assemble
+	// DataSourceName. Pass it to BuildDSN to get a DataSourceName string.
+	Connection ConnectionParams
+
+	// Logger receives one call per SQL statement OpenDB's Datastore executes.
+	// Defaults to a DefaultLogger (stdout) seeded with SlowThreshold below.
+	Logger Logger
+	// SlowThreshold is passed to the default logger's SlowThreshold field.
+	// Ignored when Logger is set explicitly.
+	SlowThreshold time.Duration
+	// Tracer optionally emits a span per statement (e.g. via OpenTelemetry).
+	// See the Tracer interface doc for wiring one up.
+	Tracer Tracer
+
+	// MaxOpenConns, MaxIdleConns, ConnMaxLifetime, and ConnMaxIdleTime are
+	// applied to the *sql.DB OpenDB returns (see database/sql's SetMaxOpenConns
+	// et al.). Zero means "leave database/sql's default".
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+
+	// ReadReplicas is a set of data source names, one per read replica,
+	// using the same DriverName as the primary. When non-empty, OpenDB
+	// routes read-only queries (Query, QueryRow, and anything built on
+	// them, e.g. QuerySet.All/One/Count) across them round-robin, falling
+	// back to the primary if every replica is unhealthy. Writes always go
+	// to the primary.
+	ReadReplicas []string
+
+	// ReplicaHealthCheckInterval controls how often each ReadReplicas entry
+	// is pinged to evict (or restore) it from the round-robin set. Defaults
+	// to 30 seconds; ignored if ReadReplicas is empty.
+	ReplicaHealthCheckInterval time.Duration
 }
 
 // OpenDB establishes a database connection based on the provided configuration and returns a Datastore.
@@ -21,6 +63,10 @@ func OpenDB(cfg Config) (*Datastore, error) {
 		adapter = &SQLiteAdapter{}
 	case "postgres":
 		adapter = &PostgresAdapter{}
+	case "mysql":
+		adapter = &MySQLAdapter{}
+	case "oracle":
+		adapter = &OracleAdapter{}
 	default:
 		return nil, fmt.Errorf("unsupported database driver: %s", cfg.DriverName)
 	}
@@ -30,8 +76,44 @@ func OpenDB(cfg Config) (*Datastore, error) {
 		return nil, err
 	}
 
-	return &Datastore{
+	if cfg.MaxOpenConns > 0 {
+		db.SetMaxOpenConns(cfg.MaxOpenConns)
+	}
+	if cfg.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(cfg.MaxIdleConns)
+	}
+	if cfg.ConnMaxLifetime > 0 {
+		db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	}
+	if cfg.ConnMaxIdleTime > 0 {
+		db.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		logger = &DefaultLogger{SlowThreshold: cfg.SlowThreshold}
+	}
+
+	ds := &Datastore{
 		DB:      db,
 		Adapter: adapter,
-	}, nil
+		Logger:  logger,
+		Tracer:  cfg.Tracer,
+	}
+
+	if len(cfg.ReadReplicas) > 0 {
+		pool, err := newReplicaPool(adapter, cfg, cfg.ReadReplicas)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to read replicas: %w", err)
+		}
+		ds.Replicas = pool
+
+		interval := cfg.ReplicaHealthCheckInterval
+		if interval <= 0 {
+			interval = 30 * time.Second
+		}
+		pool.StartHealthChecks(interval)
+	}
+
+	return ds, nil
 }
@@ -1,55 +1,72 @@
 package orm
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"reflect"
 	"strings"
 )
 
-// Create performs an INSERT operation.
-func Create(db *sql.DB, model interface{}) error {
-	tableName := getTableName(model)
-	columns, placeholders := getFieldInfo(model)
+// Delete deletes a record by ID.
+func Delete(db *sql.DB, table string, id int) error {
+	tableName := table
 
-	insertQuery := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)",
-		tableName, strings.Join(columns, ", "), strings.Join(placeholders, ", "))
+	deleteQuery := fmt.Sprintf("DELETE FROM %s WHERE id = ?", tableName)
 
-	stmt, err := db.Prepare(insertQuery)
+	stmt, err := db.Prepare(deleteQuery)
 	if err != nil {
-		return fmt.Errorf("failed to prepare insert statement: %w", err)
+		return fmt.Errorf("failed to prepare delete statement: %w", err)
 	}
 	defer stmt.Close()
 
-	val := reflect.ValueOf(model)
-	if val.Kind() == reflect.Ptr {
-		val = val.Elem()
+	_, err = stmt.Exec(id)
+	if err != nil {
+		return fmt.Errorf("failed to execute delete statement: %w", err)
 	}
 
-	args := make([]interface{}, val.NumField())
+	return nil
+}
 
-	for i := 0; i < val.NumField(); i++ {
-		args[i] = val.Field(i).Interface()
+// CreateContext is the context-aware equivalent of Create.
+func CreateContext(ctx context.Context, db *sql.DB, model interface{}) error {
+	if err := Validate(model); err != nil {
+		return err
 	}
 
-	_, err = stmt.Exec(args...)
+	tableName := GetTableName(model)
+	columns, values := GetFieldInfo(model)
+
+	placeholders := make([]string, len(columns))
+	for i := range placeholders {
+		placeholders[i] = "?"
+	}
+
+	insertQuery := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)",
+		tableName, strings.Join(columns, ", "), strings.Join(placeholders, ", "))
+
+	stmt, err := db.PrepareContext(ctx, insertQuery)
 	if err != nil {
+		return fmt.Errorf("failed to prepare insert statement: %w", err)
+	}
+	defer stmt.Close()
+
+	if _, err := stmt.ExecContext(ctx, values...); err != nil {
 		return fmt.Errorf("failed to execute insert statement: %w", err)
 	}
 
 	return nil
 }
 
-// Get retrieves a record by ID.
-func Get(db *sql.DB, table string, id int, model interface{}) error {
-	tableName := table // Use the provided table name.
+// GetContext is the context-aware equivalent of Get.
+func GetContext(ctx context.Context, db *sql.DB, table string, id int, model interface{}) error {
+	tableName := table
 
-	// Get column names from the model using reflection
-	columns, _ := getFieldInfo(model) // only need columns
+	columns, _ := GetFieldInfo(model)
 
 	selectQuery := fmt.Sprintf("SELECT %s FROM %s WHERE id = ?", strings.Join(columns, ", "), tableName)
 
-	stmt, err := db.Prepare(selectQuery)
+	stmt, err := db.PrepareContext(ctx, selectQuery)
 	if err != nil {
 		return fmt.Errorf("failed to prepare select statement: %w", err)
 	}
@@ -62,12 +79,11 @@ func Get(db *sql.DB, table string, id int, model interface{}) error {
 
 	dest := make([]interface{}, val.NumField())
 	for i := 0; i < val.NumField(); i++ {
-		dest[i] = val.Field(i).Addr().Interface() // Pass pointers to the fields for Scan
+		dest[i] = val.Field(i).Addr().Interface()
 	}
 
-	row := stmt.QueryRow(id)
-	err = row.Scan(dest...)
-	if err != nil {
+	row := stmt.QueryRowContext(ctx, id)
+	if err := row.Scan(dest...); err != nil {
 		if err == sql.ErrNoRows {
 			return fmt.Errorf("record not found with id %d", id)
 		}
@@ -77,10 +93,14 @@ func Get(db *sql.DB, table string, id int, model interface{}) error {
 	return nil
 }
 
-// Update updates a record.
-func Update(db *sql.DB, table string, id int, data interface{}) error {
+// UpdateContext is the context-aware equivalent of Update.
+func UpdateContext(ctx context.Context, db *sql.DB, table string, id int, data interface{}) error {
+	if err := Validate(data); err != nil {
+		return err
+	}
+
 	tableName := table
-	columns, _ := getFieldInfo(data)
+	columns, _ := GetFieldInfo(data)
 
 	var setClauses []string
 	for _, column := range columns {
@@ -89,7 +109,7 @@ func Update(db *sql.DB, table string, id int, data interface{}) error {
 
 	updateQuery := fmt.Sprintf("UPDATE %s SET %s WHERE id = ?", tableName, strings.Join(setClauses, ", "))
 
-	stmt, err := db.Prepare(updateQuery)
+	stmt, err := db.PrepareContext(ctx, updateQuery)
 	if err != nil {
 		return fmt.Errorf("failed to prepare update statement: %w", err)
 	}
@@ -104,31 +124,28 @@ func Update(db *sql.DB, table string, id int, data interface{}) error {
 	for i := 0; i < val.NumField(); i++ {
 		args[i] = val.Field(i).Interface()
 	}
+	args = append(args, id)
 
-	args = append(args, id) // Add the ID to the arguments for the WHERE clause
-
-	_, err = stmt.Exec(args...)
-	if err != nil {
+	if _, err := stmt.ExecContext(ctx, args...); err != nil {
 		return fmt.Errorf("failed to execute update statement: %w", err)
 	}
 
 	return nil
 }
 
-// Delete deletes a record by ID.
-func Delete(db *sql.DB, table string, id int) error {
+// DeleteContext is the context-aware equivalent of Delete.
+func DeleteContext(ctx context.Context, db *sql.DB, table string, id int) error {
 	tableName := table
 
 	deleteQuery := fmt.Sprintf("DELETE FROM %s WHERE id = ?", tableName)
 
-	stmt, err := db.Prepare(deleteQuery)
+	stmt, err := db.PrepareContext(ctx, deleteQuery)
 	if err != nil {
 		return fmt.Errorf("failed to prepare delete statement: %w", err)
 	}
 	defer stmt.Close()
 
-	_, err = stmt.Exec(id)
-	if err != nil {
+	if _, err := stmt.ExecContext(ctx, id); err != nil {
 		return fmt.Errorf("failed to execute delete statement: %w", err)
 	}
 
@@ -6,4 +6,18 @@ import "database/sql"
 type Datastore struct {
 	DB      *sql.DB
 	Adapter DBAdapter
+
+	// Logger receives one LogQuery call per statement run through this
+	// Datastore. Set from Config.Logger by OpenDB, defaulting to a
+	// DefaultLogger when the config doesn't supply one.
+	Logger Logger
+
+	// Tracer optionally emits a span per statement (e.g. via
+	// OpenTelemetry). Nil means tracing is a no-op.
+	Tracer Tracer
+
+	// Replicas, when set by OpenDB from Config.ReadReplicas, is consulted
+	// by read-only queries (see readDB) to route them to a replica instead
+	// of DB. Nil means every query runs against DB.
+	Replicas *ReplicaPool
 }
@@ -0,0 +1,34 @@
+package orm
+
+import (
+	"strconv"
+	"strings"
+)
+
+// rebindPositional rewrites query's "?" placeholders (in order) into
+// prefix+N syntax, e.g. rebindPositional(q, "$") for Postgres or
+// rebindPositional(q, ":") for Oracle. "?" occurring inside a single-quoted
+// string literal is left untouched, so a literal question mark in a value
+// doesn't get mistaken for a placeholder.
+func rebindPositional(query string, prefix string) string {
+	var b strings.Builder
+	b.Grow(len(query) + 8)
+
+	inString := false
+	n := 0
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case c == '\'':
+			inString = !inString
+			b.WriteByte(c)
+		case c == '?' && !inString:
+			n++
+			b.WriteString(prefix)
+			b.WriteString(strconv.Itoa(n))
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
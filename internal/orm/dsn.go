@@ -0,0 +1,118 @@
+package orm
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+)
+
+// ConnectionParams describes a database connection in structured form, as an
+// alternative to hand-crafting Config.DataSourceName. BuildDSN turns it into
+// a driver-specific connection string; fields that don't apply to the
+// selected DriverName are ignored.
+type ConnectionParams struct {
+	Host     string
+	Port     int
+	User     string
+	Password string
+	Database string
+
+	// SSLMode is passed through verbatim, e.g. "disable", "require",
+	// "verify-ca", "verify-full" (Postgres) or ignored (SQLite). Falls back
+	// to the PGSSLMODE environment variable when empty.
+	SSLMode     string
+	SSLRootCert string
+	SSLCert     string
+	SSLKey      string
+
+	// ConnectTimeoutSeconds bounds how long dialing the server may take.
+	ConnectTimeoutSeconds int
+	// ApplicationName is reported to the server for logging (Postgres only).
+	ApplicationName string
+	// BinaryParameters enables lib/pq's binary_parameters mode (Postgres only).
+	BinaryParameters bool
+}
+
+// BuildDSN composes a driver-specific connection string from cfg.Connection,
+// for callers who'd rather fill in ConnectionParams than hand-assemble
+// Config.DataSourceName. The result is suitable for Config.DataSourceName.
+func BuildDSN(cfg Config) (string, error) {
+	switch cfg.DriverName {
+	case "sqlite3":
+		return buildSQLiteDSN(cfg.DataSourceName, cfg.Connection), nil
+	case "postgres":
+		return buildPostgresDSN(cfg.Connection), nil
+	default:
+		return "", fmt.Errorf("orm: BuildDSN does not support driver %q", cfg.DriverName)
+	}
+}
+
+// buildSQLiteDSN composes a "file:" URI DSN carrying the journal-mode and
+// foreign-key pragmas as query parameters, per the mattn/go-sqlite3 driver's
+// DSN conventions. path is cfg.DataSourceName, which is still where the
+// on-disk file path (or ":memory:") is taken from.
+func buildSQLiteDSN(path string, p ConnectionParams) string {
+	params := url.Values{}
+	params.Set("_journal_mode", "WAL")
+	params.Set("_foreign_keys", "on")
+	if p.ConnectTimeoutSeconds > 0 {
+		params.Set("_busy_timeout", strconv.Itoa(p.ConnectTimeoutSeconds*1000))
+	}
+	return "file:" + path + "?" + params.Encode()
+}
+
+// buildPostgresDSN composes a "postgres://" URL DSN, honoring the PGSSLMODE
+// environment variable when p.SSLMode is unset, matching libpq's own
+// fallback behavior.
+func buildPostgresDSN(p ConnectionParams) string {
+	u := &url.URL{Scheme: "postgres"}
+
+	if p.User != "" {
+		if p.Password != "" {
+			u.User = url.UserPassword(p.User, p.Password)
+		} else {
+			u.User = url.User(p.User)
+		}
+	}
+
+	host := p.Host
+	if host == "" {
+		host = "localhost"
+	}
+	if p.Port != 0 {
+		host = fmt.Sprintf("%s:%d", host, p.Port)
+	}
+	u.Host = host
+	u.Path = "/" + p.Database
+
+	q := url.Values{}
+	sslMode := p.SSLMode
+	if sslMode == "" {
+		sslMode = os.Getenv("PGSSLMODE")
+	}
+	if sslMode != "" {
+		q.Set("sslmode", sslMode)
+	}
+	if p.SSLRootCert != "" {
+		q.Set("sslrootcert", p.SSLRootCert)
+	}
+	if p.SSLCert != "" {
+		q.Set("sslcert", p.SSLCert)
+	}
+	if p.SSLKey != "" {
+		q.Set("sslkey", p.SSLKey)
+	}
+	if p.ConnectTimeoutSeconds > 0 {
+		q.Set("connect_timeout", strconv.Itoa(p.ConnectTimeoutSeconds))
+	}
+	if p.ApplicationName != "" {
+		q.Set("application_name", p.ApplicationName)
+	}
+	if p.BinaryParameters {
+		q.Set("binary_parameters", "yes")
+	}
+	u.RawQuery = q.Encode()
+
+	return u.String()
+}
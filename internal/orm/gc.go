@@ -0,0 +1,229 @@
+package orm
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// GCObserver receives one call per table swept by a GC pass, so callers can
+// wire sweep counts and errors into metrics.
+type GCObserver interface {
+	OnSweep(table string, deleted int, err error)
+}
+
+// GCOptions configures a GC sweeper. The zero value is valid: Interval
+// defaults to 5 minutes and ChunkSize to 500.
+type GCOptions struct {
+	// Interval is how often Start sweeps all registered tables.
+	Interval time.Duration
+	// ChunkSize bounds how many rows a single DELETE removes, so a sweep
+	// over a large backlog doesn't hold a lock for long. Default 500.
+	ChunkSize int
+	// Observer, if set, is called once per table after every sweep pass.
+	Observer GCObserver
+	// Models is registered with GC.Register (column discovered from each
+	// model's `gc:"..."`-tagged field) before the sweeper starts. Models
+	// needing an explicit, untagged column should call Register directly on
+	// the *GC returned by NewGC instead.
+	Models []any
+}
+
+// gcTarget is one table registered for expiry sweeping.
+type gcTarget struct {
+	table  string
+	column string
+}
+
+// GC periodically deletes expired rows - sessions, tokens, cache entries -
+// from tables registered with Register. Only one GC (across processes)
+// sweeps a given database at a time; see acquireGCLock.
+type GC struct {
+	ds   *Datastore
+	opts GCOptions
+
+	mu      sync.Mutex
+	targets []gcTarget
+}
+
+// NewGC creates a GC against ds, applying opts' defaults and registering
+// opts.Models.
+func NewGC(ds *Datastore, opts GCOptions) *GC {
+	if opts.Interval <= 0 {
+		opts.Interval = 5 * time.Minute
+	}
+	if opts.ChunkSize <= 0 {
+		opts.ChunkSize = 500
+	}
+	g := &GC{ds: ds, opts: opts}
+	for _, model := range opts.Models {
+		g.Register(model, "")
+	}
+	return g
+}
+
+// StartGC builds a GC from opts and immediately starts it; see GC.Start.
+func StartGC(ds *Datastore, opts GCOptions) (stop func()) {
+	return NewGC(ds, opts).Start()
+}
+
+// Register adds model's table to the sweep set, expiring rows whose column
+// has passed. If column is empty, it's taken from the field tagged
+// `gc:"<column>"`, e.g.:
+//
+//	type Session struct {
+//		ID        string `pk:"true"`
+//		ExpiresAt time.Time `gc:"expires_at"`
+//	}
+func (g *GC) Register(model any, column string) error {
+	if column == "" {
+		col, err := gcColumnFromTag(model)
+		if err != nil {
+			return err
+		}
+		column = col
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.targets = append(g.targets, gcTarget{table: GetTableName(model), column: column})
+	return nil
+}
+
+// gcColumnFromTag finds model's `gc:"..."`-tagged field and returns its tag
+// value as the expiry column name.
+func gcColumnFromTag(model any) (string, error) {
+	t := reflect.TypeOf(model)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if col := t.Field(i).Tag.Get("gc"); col != "" {
+			return col, nil
+		}
+	}
+	return "", fmt.Errorf("orm: %s has no field tagged `gc:\"...\"` and no column was given", t.Name())
+}
+
+// Start launches the sweeper on its own goroutine, running one pass
+// immediately and then every g.opts.Interval, until the returned stop func
+// is called.
+func (g *GC) Start() (stop func()) {
+	done := make(chan struct{})
+	finished := make(chan struct{})
+	ticker := time.NewTicker(g.opts.Interval)
+
+	go func() {
+		defer close(finished)
+		defer ticker.Stop()
+		g.Sweep()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				g.Sweep()
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+		<-finished
+	}
+}
+
+// Sweep runs one pass over every registered table, reporting through
+// g.opts.Observer if set. It takes the advisory GC lock first so that only
+// one process sweeps a given database at a time; on Postgres a concurrent
+// sweeper's lock makes this wait rather than fail. If taking the lock returns
+// an error (e.g. the adapter doesn't support advisory locking, or the lock
+// connection can't be opened), every registered table is reported with that
+// error and nothing is deleted.
+func (g *GC) Sweep() {
+	lk, err := acquireGCLock(g.ds)
+	if err != nil {
+		g.reportAll(0, err)
+		return
+	}
+	defer lk.release()
+
+	g.mu.Lock()
+	targets := append([]gcTarget(nil), g.targets...)
+	g.mu.Unlock()
+
+	for _, tgt := range targets {
+		deleted, err := g.sweepTable(lk, tgt)
+		if g.opts.Observer != nil {
+			g.opts.Observer.OnSweep(tgt.table, deleted, err)
+		}
+	}
+}
+
+// reportAll notifies the observer of the same (deleted, err) for every
+// registered table, e.g. when the advisory lock couldn't be taken at all.
+func (g *GC) reportAll(deleted int, err error) {
+	if g.opts.Observer == nil {
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, tgt := range g.targets {
+		g.opts.Observer.OnSweep(tgt.table, deleted, err)
+	}
+}
+
+// sweepTable deletes expired rows from tgt.table in chunks of
+// g.opts.ChunkSize, stopping once a chunk deletes fewer than that many rows.
+func (g *GC) sweepTable(lk *gcLock, tgt gcTarget) (int, error) {
+	total := 0
+	for {
+		n, err := g.sweepChunk(lk, tgt)
+		if err != nil {
+			return total, err
+		}
+		total += n
+		if n < g.opts.ChunkSize {
+			return total, nil
+		}
+	}
+}
+
+// sweepChunk deletes up to one chunk of rows past their expiry in tgt.table,
+// scoped to lk's held lock the same way migrate steps are scoped to theirs:
+// on SQLite the lock is itself a write transaction, so the delete runs
+// inside it rather than opening a second connection that would deadlock
+// against it; on Postgres the advisory lock doesn't block other
+// connections, so the delete runs directly against the pool.
+//
+// Neither dialect's DELETE supports a bare LIMIT by default (SQLite needs a
+// non-default compile flag, Postgres doesn't support it at all), so both use
+// a row-identifier subquery to bound the chunk instead.
+func (g *GC) sweepChunk(lk *gcLock, tgt gcTarget) (int, error) {
+	switch g.ds.Adapter.(type) {
+	case *SQLiteAdapter:
+		query := fmt.Sprintf(
+			"DELETE FROM %s WHERE rowid IN (SELECT rowid FROM %s WHERE %s < ? LIMIT ?)",
+			tgt.table, tgt.table, tgt.column)
+		res, err := lk.tx.Exec(query, time.Now(), g.opts.ChunkSize)
+		if err != nil {
+			return 0, fmt.Errorf("gc: failed to sweep %s: %w", tgt.table, err)
+		}
+		affected, err := res.RowsAffected()
+		return int(affected), err
+	case *PostgresAdapter:
+		query := fmt.Sprintf(
+			"DELETE FROM %s WHERE ctid IN (SELECT ctid FROM %s WHERE %s < $1 LIMIT $2)",
+			tgt.table, tgt.table, tgt.column)
+		res, err := g.ds.DB.Exec(query, time.Now(), g.opts.ChunkSize)
+		if err != nil {
+			return 0, fmt.Errorf("gc: failed to sweep %s: %w", tgt.table, err)
+		}
+		affected, err := res.RowsAffected()
+		return int(affected), err
+	default:
+		return 0, fmt.Errorf("gc: sweeping is not supported for this adapter")
+	}
+}
@@ -0,0 +1,88 @@
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// gcLockID is the arbitrary fixed key every GC takes Postgres's session-level
+// advisory lock on, so concurrent sweepers across separate
+// connections/processes serialize rather than each sweeping independently.
+// Distinct from migrate's own lock ID so a sweep and a migration can proceed
+// concurrently.
+const gcLockID = 78413 // arbitrary; unique enough not to collide with app locks
+
+// gcLock represents a held GC advisory lock, mirroring internal/migrate's
+// lock: see its doc comment for why SQLite's lock doubles as the transaction
+// every sweep step must run inside, while Postgres's is a true session lock
+// that other connections can write alongside.
+type gcLock struct {
+	tx        *sql.Tx
+	releaseFn func() error
+}
+
+func (lk *gcLock) release() error {
+	return lk.releaseFn()
+}
+
+// acquireGCLock takes a driver-appropriate lock that prevents two GCs from
+// sweeping the same database concurrently.
+func acquireGCLock(ds *Datastore) (*gcLock, error) {
+	switch ds.Adapter.(type) {
+	case *SQLiteAdapter:
+		return acquireSQLiteGCLock(ds.DB)
+	case *PostgresAdapter:
+		return acquirePostgresGCLock(ds.DB)
+	default:
+		return nil, fmt.Errorf("gc: advisory locking is not supported for this adapter")
+	}
+}
+
+// acquireSQLiteGCLock opens a dedicated connection and starts a transaction
+// on it, which doubles as both the lock and the scope every sweep chunk runs
+// under - see gcLock.tx's doc comment.
+func acquireSQLiteGCLock(db *sql.DB) (*gcLock, error) {
+	conn, err := db.Conn(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open lock connection: %w", err)
+	}
+
+	tx, err := conn.BeginTx(context.Background(), nil)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to begin lock transaction: %w", err)
+	}
+
+	return &gcLock{
+		tx: tx,
+		releaseFn: func() error {
+			err := tx.Commit()
+			conn.Close()
+			return err
+		},
+	}, nil
+}
+
+// acquirePostgresGCLock takes a session-level pg_advisory_lock on a
+// dedicated connection, held regardless of which connection each sweep
+// chunk runs against, and released on that same connection.
+func acquirePostgresGCLock(db *sql.DB) (*gcLock, error) {
+	conn, err := db.Conn(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open lock connection: %w", err)
+	}
+
+	if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_lock($1)", gcLockID); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to take pg_advisory_lock: %w", err)
+	}
+
+	return &gcLock{
+		releaseFn: func() error {
+			_, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", gcLockID)
+			conn.Close()
+			return err
+		},
+	}, nil
+}
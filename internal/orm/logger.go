@@ -0,0 +1,111 @@
+package orm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+// Logger receives one call per SQL statement Liteforge executes, after it
+// completes (successfully or not). Set it via Config.Logger (or Datastore.Logger
+// directly) to redirect or silence query logging.
+type Logger interface {
+	LogQuery(ctx context.Context, sql string, args []any, duration time.Duration, err error)
+}
+
+// Tracer lets callers plug in distributed tracing (e.g. OpenTelemetry)
+// without Liteforge depending on a tracing SDK directly. StartSpan is called
+// before a statement runs and must return the (possibly derived) context to
+// use for the call and a function that ends the span, passed the call's
+// resulting error. A Tracer backed by go.opentelemetry.io/otel can implement
+// this by wrapping tracer.Start and span.End/span.RecordError.
+//
+// Together with Logger, Tracer.StartSpan/endSpan already give every
+// instrumented call (see instrument below) a before/after hook point, so
+// there's no separate query-hook interface to register.
+type Tracer interface {
+	StartSpan(ctx context.Context, name string) (context.Context, func(err error))
+}
+
+// DefaultLogger prints each query to stdout (via a standard log.Logger)
+// with its placeholders inlined for copy-paste debugging, escalating the
+// line's level to "SLOW QUERY" once duration reaches SlowThreshold.
+type DefaultLogger struct {
+	// SlowThreshold escalates the log level when a query's duration meets or
+	// exceeds it. Zero disables escalation.
+	SlowThreshold time.Duration
+}
+
+var stdoutLogger = log.New(os.Stdout, "", log.LstdFlags)
+
+// LogQuery implements Logger.
+func (l *DefaultLogger) LogQuery(ctx context.Context, sqlQuery string, args []any, duration time.Duration, err error) {
+	level := "QUERY"
+	switch {
+	case err != nil:
+		level = "QUERY ERROR"
+	case l.SlowThreshold > 0 && duration >= l.SlowThreshold:
+		level = "SLOW QUERY"
+	}
+
+	if err != nil {
+		stdoutLogger.Printf("[liteforge] %s (%s): %s -- error: %v", level, duration, inlineArgs(sqlQuery, args), err)
+		return
+	}
+	stdoutLogger.Printf("[liteforge] %s (%s): %s", level, duration, inlineArgs(sqlQuery, args))
+}
+
+// inlineArgs substitutes each "?" or "$N" placeholder in query with its
+// corresponding arg for human-readable log output. It is never used to
+// build a query that actually executes, so it does no SQL escaping.
+func inlineArgs(query string, args []any) string {
+	if len(args) == 0 {
+		return query
+	}
+
+	out := query
+	if strings.Contains(query, "$1") {
+		for i, arg := range args {
+			out = strings.Replace(out, fmt.Sprintf("$%d", i+1), renderArg(arg), 1)
+		}
+		return out
+	}
+	for _, arg := range args {
+		out = strings.Replace(out, "?", renderArg(arg), 1)
+	}
+	return out
+}
+
+func renderArg(arg any) string {
+	if s, ok := arg.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+	return fmt.Sprintf("%v", arg)
+}
+
+// instrument runs fn, timing it, then reports the outcome to ds's Logger and
+// Tracer (ds itself is never nil at call sites; its Logger/Tracer may be).
+// fn should perform the query/exec and return only its error - callers
+// capture the actual result (rows, a *sql.Row, sql.Result, ...) via a
+// closure variable before returning.
+func instrument(ctx context.Context, ds *Datastore, query string, args []any, fn func() error) error {
+	var endSpan func(error)
+	if ds.Tracer != nil {
+		ctx, endSpan = ds.Tracer.StartSpan(ctx, "liteforge.query")
+	}
+
+	start := time.Now()
+	err := fn()
+	duration := time.Since(start)
+
+	if ds.Logger != nil {
+		ds.Logger.LogQuery(ctx, query, args, duration, err)
+	}
+	if endSpan != nil {
+		endSpan(err)
+	}
+	return err
+}
@@ -0,0 +1,251 @@
+package orm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ColumnInfo describes a single column as introspected from the live
+// database schema, independent of each adapter's native PRAGMA/
+// information_schema shape.
+type ColumnInfo struct {
+	Name    string
+	Type    string
+	NotNull bool
+	PK      bool
+}
+
+// ColumnAction identifies which statement AlterColumnSQL should render.
+type ColumnAction int
+
+const (
+	// ColumnActionAdd adds col to a table.
+	ColumnActionAdd ColumnAction = iota
+	// ColumnActionDrop removes col from a table.
+	ColumnActionDrop
+)
+
+// Migrator provides schema migration operations beyond CreateTable's
+// first-time creation: adding/dropping columns and indexes, and diffing a
+// struct against the live schema via AutoMigrate.
+type Migrator struct {
+	ds *Datastore
+}
+
+// Migrator returns a Migrator bound to ds.
+func (ds *Datastore) Migrator() *Migrator {
+	return &Migrator{ds: ds}
+}
+
+// HasTable reports whether model's table exists in the database.
+func (m *Migrator) HasTable(model any) (bool, error) {
+	cols, err := m.ds.Adapter.IntrospectTable(m.ds.DB, GetTableName(model))
+	if err != nil {
+		return false, fmt.Errorf("failed to introspect table: %w", err)
+	}
+	return len(cols) > 0, nil
+}
+
+// HasColumn reports whether column exists on model's table.
+func (m *Migrator) HasColumn(model any, column string) (bool, error) {
+	cols, err := m.ds.Adapter.IntrospectTable(m.ds.DB, GetTableName(model))
+	if err != nil {
+		return false, fmt.Errorf("failed to introspect table: %w", err)
+	}
+	for _, c := range cols {
+		if strings.EqualFold(c.Name, column) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// AddColumn adds fieldName - a Go struct field on model, not a column name -
+// as a new column on model's table, using the adapter's AlterColumnSQL type
+// mapping (on MySQL, strings become TEXT even where CreateTableSQL would pick
+// VARCHAR(255)). For a belongs-to field, this adds its FK column.
+func (m *Migrator) AddColumn(model any, fieldName string) error {
+	tableName := GetTableName(model)
+	col, err := columnInfoForField(model, fieldName)
+	if err != nil {
+		return err
+	}
+	stmts, err := m.ds.Adapter.AlterColumnSQL(m.ds.DB, tableName, col, ColumnActionAdd)
+	if err != nil {
+		return fmt.Errorf("failed to generate add-column SQL: %w", err)
+	}
+	return m.exec(stmts)
+}
+
+// DropColumn drops columnName (a DB column name) from model's table.
+func (m *Migrator) DropColumn(model any, columnName string) error {
+	tableName := GetTableName(model)
+	stmts, err := m.ds.Adapter.AlterColumnSQL(m.ds.DB, tableName, ColumnInfo{Name: columnName}, ColumnActionDrop)
+	if err != nil {
+		return fmt.Errorf("failed to generate drop-column SQL: %w", err)
+	}
+	return m.exec(stmts)
+}
+
+// CreateIndex creates a non-unique index named name on model's table over cols.
+func (m *Migrator) CreateIndex(model any, name string, cols ...string) error {
+	return m.createIndex(model, name, cols, false)
+}
+
+// CreateUniqueIndex is CreateIndex's unique-constraint equivalent, used for
+// fields tagged `liteforge:"unique"`.
+func (m *Migrator) CreateUniqueIndex(model any, name string, cols ...string) error {
+	return m.createIndex(model, name, cols, true)
+}
+
+func (m *Migrator) createIndex(model any, name string, cols []string, unique bool) error {
+	uniqueKeyword := ""
+	if unique {
+		uniqueKeyword = "UNIQUE "
+	}
+	query := fmt.Sprintf("CREATE %sINDEX IF NOT EXISTS %s ON %s (%s)",
+		uniqueKeyword, name, GetTableName(model), strings.Join(cols, ", "))
+	if err := m.exec([]string{query}); err != nil {
+		return fmt.Errorf("failed to create index %q: %w", name, err)
+	}
+	return nil
+}
+
+// DropIndex drops the named index, if it exists.
+func (m *Migrator) DropIndex(model any, name string) error {
+	if err := m.exec([]string{fmt.Sprintf("DROP INDEX IF EXISTS %s", name)}); err != nil {
+		return fmt.Errorf("failed to drop index %q: %w", name, err)
+	}
+	return nil
+}
+
+// AutoMigrate reconciles each model's table with the database: creating the
+// table if it's missing, adding any struct fields that don't yet have a
+// column, and creating indexes for fields tagged `liteforge:"index"` or
+// `liteforge:"unique"`. Like gorm's AutoMigrate, it never drops columns or
+// indexes absent from the struct - use DropColumn/DropIndex for that.
+func (m *Migrator) AutoMigrate(models ...any) error {
+	for _, model := range models {
+		hasTable, err := m.HasTable(model)
+		if err != nil {
+			return err
+		}
+		if !hasTable {
+			if err := CreateTable(m.ds, model); err != nil {
+				return err
+			}
+		} else if err := m.addMissingColumns(model); err != nil {
+			return err
+		}
+
+		if err := m.createTaggedIndexes(model); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) addMissingColumns(model any) error {
+	tableName := GetTableName(model)
+	existing, err := m.ds.Adapter.IntrospectTable(m.ds.DB, tableName)
+	if err != nil {
+		return fmt.Errorf("failed to introspect table %q: %w", tableName, err)
+	}
+	have := make(map[string]bool, len(existing))
+	for _, c := range existing {
+		have[strings.ToLower(c.Name)] = true
+	}
+
+	for _, fieldName := range structColumnFields(model) {
+		col, err := columnInfoForField(model, fieldName)
+		if err != nil {
+			return err
+		}
+		if have[strings.ToLower(col.Name)] {
+			continue
+		}
+		if err := m.AddColumn(model, fieldName); err != nil {
+			return fmt.Errorf("failed to add column %q to %q: %w", col.Name, tableName, err)
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) createTaggedIndexes(model any) error {
+	for _, idx := range GetIndexFields(model) {
+		name := fmt.Sprintf("idx_%s_%s", GetTableName(model), idx.Column)
+		if idx.Unique {
+			if err := m.CreateUniqueIndex(model, name, idx.Column); err != nil {
+				return err
+			}
+		} else if err := m.CreateIndex(model, name, idx.Column); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exec runs each statement in order, instrumented through m.ds's
+// Logger/Tracer like every other query path.
+func (m *Migrator) exec(stmts []string) error {
+	for _, stmt := range stmts {
+		err := instrument(context.Background(), m.ds, stmt, nil, func() error {
+			_, err := m.ds.DB.Exec(stmt)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("failed to execute migration statement %q: %w", stmt, err)
+		}
+	}
+	return nil
+}
+
+// columnInfoForField resolves a Go struct field on model to the ColumnInfo
+// AlterColumnSQL needs: its DB column name and Go type, redirected to the FK
+// column for a belongs-to field.
+func columnInfoForField(model any, fieldName string) (ColumnInfo, error) {
+	t := reflect.TypeOf(model)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	field, ok := t.FieldByName(fieldName)
+	if !ok {
+		return ColumnInfo{}, fmt.Errorf("model %s has no field %q", t.Name(), fieldName)
+	}
+
+	columnName := strings.ToLower(field.Name)
+	goType := field.Type.String()
+	if rel, ok := GetRelations(model)[field.Name]; ok {
+		switch rel.Kind {
+		case RelationBelongsTo:
+			columnName = rel.FKColumn
+			goType = "int"
+		default:
+			return ColumnInfo{}, fmt.Errorf("field %q is a has-many/many-to-many relation, not a column", fieldName)
+		}
+	}
+
+	return ColumnInfo{Name: columnName, Type: goType, PK: field.Tag.Get("pk") == "true"}, nil
+}
+
+// structColumnFields returns the Go struct field names on model that back a
+// real column - i.e. every field except has-many/many-to-many relations.
+func structColumnFields(model any) []string {
+	t := reflect.TypeOf(model)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	relations := GetRelations(model)
+
+	fields := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if rel, ok := relations[field.Name]; ok && rel.Kind != RelationBelongsTo {
+			continue
+		}
+		fields = append(fields, field.Name)
+	}
+	return fields
+}
@@ -0,0 +1,335 @@
+package orm
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	_ "github.com/sijms/go-ora/v2" // For Oracle
+)
+
+// OracleAdapter implements the DBAdapter for Oracle Database.
+type OracleAdapter struct {
+	// MaxBulkParams caps how many "?" placeholders BulkInsert packs into a
+	// single INSERT ALL statement, mirroring SQLiteAdapter.MaxBulkParams.
+	// Zero uses oracleDefaultMaxBulkParams.
+	MaxBulkParams int
+}
+
+// oracleDefaultMaxBulkParams mirrors the other adapters' defaults; Oracle's
+// actual limit on bind variables per statement is much higher, but this
+// keeps INSERT ALL statements a predictable size.
+const oracleDefaultMaxBulkParams = 900
+
+// Connect establishes an Oracle database connection.
+func (a *OracleAdapter) Connect(cfg Config) (*sql.DB, error) {
+	db, err := sql.Open("oracle", cfg.DataSourceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	// Test the connection
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+	return db, nil
+}
+
+// oracleNeedsVarchar reports whether a string field must be declared
+// VARCHAR2(255) rather than CLOB, mirroring mysqlNeedsVarchar: Oracle can
+// only index a bounded-length column.
+func oracleNeedsVarchar(field reflect.StructField) bool {
+	if field.Tag.Get("pk") == "true" {
+		return true
+	}
+	if strings.Contains(strings.ToLower(field.Tag.Get("db")), "unique") {
+		return true
+	}
+	liteforgeTag := field.Tag.Get("liteforge")
+	return strings.Contains(liteforgeTag, "index") || strings.Contains(liteforgeTag, "unique")
+}
+
+// CreateTableSQL generates the Oracle-specific CREATE TABLE SQL statement.
+// Auto-increment primary keys use Oracle 12c+'s identity columns rather
+// than the sequence-plus-trigger idiom older Oracle versions need, since
+// this package doesn't have a migration step to create a matching sequence
+// and trigger alongside the table.
+func (a *OracleAdapter) CreateTableSQL(model interface{}) (string, error) {
+	if model == nil {
+		return "", errors.New("no model passed in. model was nil")
+	}
+
+	t := reflect.TypeOf(model)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return "", errors.New("model must be a struct or pointer to struct")
+	}
+	tableName := GetTableName(model)
+
+	var columnDefinitions []string
+
+	val := reflect.ValueOf(model)
+	if val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+	t = val.Type()
+	relations := GetRelations(model)
+	for i := 0; i < val.NumField(); i++ {
+		field := t.Field(i)
+
+		if rel, ok := relations[field.Name]; ok {
+			switch rel.Kind {
+			case RelationHasMany, RelationManyToMany:
+				continue
+			case RelationBelongsTo:
+				columnDefinitions = append(columnDefinitions, fmt.Sprintf("%s NUMBER", a.QuoteIdent(rel.FKColumn)))
+				continue
+			}
+		}
+
+		columnName := strings.ToLower(field.Name)
+		fieldType := field.Type.String()
+		sqlType := ""
+
+		switch fieldType {
+		case "int", "int64", "int32", "int16", "int8":
+			sqlType = "NUMBER"
+		case "string":
+			if oracleNeedsVarchar(field) {
+				sqlType = "VARCHAR2(255)"
+			} else {
+				sqlType = "CLOB"
+			}
+		case "float64", "float32":
+			sqlType = "BINARY_DOUBLE"
+		case "bool":
+			sqlType = "NUMBER(1)"
+		default:
+			sqlType = "CLOB"
+		}
+
+		pkTag := field.Tag.Get("pk")
+		if pkTag == "true" {
+			if sqlType == "NUMBER" {
+				sqlType += " GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY"
+			} else {
+				sqlType += " PRIMARY KEY"
+			}
+		}
+
+		columnDefinitions = append(columnDefinitions, fmt.Sprintf("%s %s", a.QuoteIdent(columnName), sqlType))
+	}
+
+	createQuery := fmt.Sprintf("CREATE TABLE %s (%s)", a.QuoteIdent(tableName), strings.Join(columnDefinitions, ", "))
+	return createQuery, nil
+}
+
+// GetPlaceholder returns Oracle's positional bind-variable placeholder,
+// e.g. ":1", ":2".
+func (a *OracleAdapter) GetPlaceholder(index int) string {
+	return fmt.Sprintf(":%d", index)
+}
+
+// QuoteIdent quotes name with Oracle's double-quote identifier syntax.
+func (a *OracleAdapter) QuoteIdent(name string) string {
+	return `"` + name + `"`
+}
+
+// Query executes a generic query.
+func (a *OracleAdapter) Query(db *sql.DB, query string, args ...interface{}) (*sql.Rows, error) {
+	stmt, err := db.Prepare(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare query: %w", err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.Query(args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return rows, nil
+}
+
+// BeginTx starts a database transaction.
+func (a *OracleAdapter) BeginTx(db *sql.DB) (*sql.Tx, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	return tx, nil
+}
+
+// OperatorSQL renders the QuerySet lookup expression for Oracle. Oracle's
+// LIKE is case-sensitive, so the "i"-prefixed lookups are only approximated
+// here.
+func (a *OracleAdapter) OperatorSQL(op string, column string) string {
+	switch op {
+	case "iexact", "exact":
+		return column + " = ?"
+	case "contains", "icontains":
+		return column + " LIKE ?"
+	case "startswith", "istartswith":
+		return column + " LIKE ?"
+	case "endswith", "iendswith":
+		return column + " LIKE ?"
+	case "gt":
+		return column + " > ?"
+	case "gte":
+		return column + " >= ?"
+	case "lt":
+		return column + " < ?"
+	case "lte":
+		return column + " <= ?"
+	default:
+		return column + " = ?"
+	}
+}
+
+// IntrospectTable reads Oracle's column metadata from USER_TAB_COLUMNS,
+// joined against USER_CONSTRAINTS/USER_CONS_COLUMNS to flag primary key
+// columns. If tableName does not exist, the query returns zero rows rather
+// than erroring, so the empty, error-free result is how callers detect that.
+func (a *OracleAdapter) IntrospectTable(db *sql.DB, tableName string) ([]ColumnInfo, error) {
+	rows, err := db.Query(`
+		SELECT c.column_name, c.data_type, c.nullable,
+		       CASE WHEN pk.column_name IS NOT NULL THEN 1 ELSE 0 END AS is_pk
+		FROM user_tab_columns c
+		LEFT JOIN (
+			SELECT ucc.column_name
+			FROM user_constraints uc
+			JOIN user_cons_columns ucc ON ucc.constraint_name = uc.constraint_name
+			WHERE uc.constraint_type = 'P' AND uc.table_name = UPPER(:1)
+		) pk ON pk.column_name = c.column_name
+		WHERE c.table_name = UPPER(:1)`, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to introspect table %q: %w", tableName, err)
+	}
+	defer rows.Close()
+
+	var columns []ColumnInfo
+	for rows.Next() {
+		var colName, dataType, nullable string
+		var isPK int
+		if err := rows.Scan(&colName, &dataType, &nullable, &isPK); err != nil {
+			return nil, fmt.Errorf("failed to scan user_tab_columns row: %w", err)
+		}
+		columns = append(columns, ColumnInfo{
+			Name:    strings.ToLower(colName),
+			Type:    dataType,
+			NotNull: nullable == "N",
+			PK:      isPK == 1,
+		})
+	}
+	return columns, rows.Err()
+}
+
+// AlterColumnSQL renders the ALTER statement for Oracle, which - like
+// Postgres and MySQL - supports native ADD and DROP COLUMN.
+func (a *OracleAdapter) AlterColumnSQL(db *sql.DB, tableName string, col ColumnInfo, action ColumnAction) ([]string, error) {
+	switch action {
+	case ColumnActionAdd:
+		return []string{fmt.Sprintf("ALTER TABLE %s ADD (%s %s)", a.QuoteIdent(tableName), a.QuoteIdent(col.Name), oracleGoTypeToSQL(col.Type))}, nil
+	case ColumnActionDrop:
+		return []string{fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", a.QuoteIdent(tableName), a.QuoteIdent(col.Name))}, nil
+	default:
+		return nil, fmt.Errorf("unsupported column action: %v", action)
+	}
+}
+
+// BulkInsert emits a chunked INSERT ALL statement, Oracle's multi-row insert
+// idiom - unlike SQLite/MySQL/Postgres, Oracle has no multi-row VALUES list,
+// so each row needs its own "INTO table (cols) VALUES (...)" clause followed
+// by a dummy "SELECT * FROM dual".
+func (a *OracleAdapter) BulkInsert(db *sql.DB, table string, cols []string, rows [][]any) (sql.Result, error) {
+	if len(rows) == 0 {
+		return bulkResult{}, nil
+	}
+
+	maxParams := a.MaxBulkParams
+	if maxParams <= 0 {
+		maxParams = oracleDefaultMaxBulkParams
+	}
+	rowsPerChunk := maxParams / len(cols)
+	if rowsPerChunk < 1 {
+		rowsPerChunk = 1
+	}
+
+	quotedTable := a.QuoteIdent(table)
+	quotedCols := make([]string, len(cols))
+	for i, c := range cols {
+		quotedCols[i] = a.QuoteIdent(c)
+	}
+	columnList := strings.Join(quotedCols, ", ")
+
+	var total int64
+	for start := 0; start < len(rows); start += rowsPerChunk {
+		end := start + rowsPerChunk
+		if end > len(rows) {
+			end = len(rows)
+		}
+		chunk := rows[start:end]
+
+		var clauses []string
+		args := make([]any, 0, len(chunk)*len(cols))
+		placeholderIdx := 1
+		for _, row := range chunk {
+			placeholders := make([]string, len(cols))
+			for i := range cols {
+				placeholders[i] = a.GetPlaceholder(placeholderIdx)
+				placeholderIdx++
+			}
+			clauses = append(clauses, fmt.Sprintf("INTO %s (%s) VALUES (%s)", quotedTable, columnList, strings.Join(placeholders, ", ")))
+			args = append(args, row...)
+		}
+
+		query := fmt.Sprintf("INSERT ALL %s SELECT * FROM dual", strings.Join(clauses, " "))
+		res, err := db.Exec(query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute bulk insert chunk: %w", err)
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read rows affected: %w", err)
+		}
+		total += affected
+	}
+
+	return bulkResult{rowsAffected: total}, nil
+}
+
+// oracleGoTypeToSQL maps a Go field type (as rendered by reflect.Type.String)
+// to its Oracle column type, mirroring CreateTableSQL's inline switch.
+func oracleGoTypeToSQL(goType string) string {
+	switch goType {
+	case "int", "int64", "int32", "int16", "int8":
+		return "NUMBER"
+	case "string":
+		return "CLOB"
+	case "float64", "float32":
+		return "BINARY_DOUBLE"
+	case "bool":
+		return "NUMBER(1)"
+	default:
+		return "CLOB"
+	}
+}
+
+// Rebind rewrites "?" placeholders into Oracle's ":1", ":2", ... syntax.
+func (a *OracleAdapter) Rebind(query string) string {
+	return rebindPositional(query, ":")
+}
+
+// HasReturningID is false: Oracle's RETURNING clause needs an OUT bind
+// variable (RETURNING id INTO :out), which doesn't fit database/sql's
+// QueryRow-based flow the way Postgres's RETURNING does. Retrieving a
+// generated ID on this adapter needs a driver-specific out-parameter API,
+// which is out of scope here, so Insert falls back to whatever
+// sql.Result.LastInsertId the driver happens to support (typically none).
+func (a *OracleAdapter) HasReturningID() bool { return false }
+
+// AppendReturning is never called since HasReturningID is false.
+func (a *OracleAdapter) AppendReturning(query string, pkCol string) string { return query }
@@ -0,0 +1,23 @@
+package orm
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Querier is the subset of *sql.DB and *sql.Tx used to run queries and
+// statements. Context-aware query/exec helpers and repository methods are
+// written against this interface rather than *sql.DB directly, so the same
+// code path runs whether or not it's inside a transaction opened via
+// (*model.ORMRepository).WithTx.
+type Querier interface {
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+var (
+	_ Querier = (*sql.DB)(nil)
+	_ Querier = (*sql.Tx)(nil)
+)
@@ -1,54 +1,132 @@
 package orm
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
 )
 
-// Query performs a custom SQL query using the Datastore's adapter.
+// Query performs a custom SQL query using the Datastore's adapter,
+// instrumented through ds's Logger/Tracer. It reads from a replica (see
+// Config.ReadReplicas) when one is configured and healthy.
 func Query(ds *Datastore, query string, args ...any) (*sql.Rows, error) {
 	if ds == nil || ds.DB == nil || ds.Adapter == nil {
 		return nil, fmt.Errorf("datastore, database connection, or adapter was nil")
 	}
-	return ds.Adapter.Query(ds.DB, query, args...)
+
+	var rows *sql.Rows
+	err := instrument(context.Background(), ds, query, args, func() error {
+		var err error
+		rows, err = ds.Adapter.Query(ds.readDB(), query, args...)
+		return err
+	})
+	return rows, err
 }
 
-// QueryRow performs a custom SQL query for a single row.
+// QueryRow performs a custom SQL query for a single row, instrumented
+// through ds's Logger/Tracer. It reads from a replica (see
+// Config.ReadReplicas) when one is configured and healthy.
 func QueryRow(ds *Datastore, query string, args ...any) (*sql.Row, error) {
 	if ds == nil || ds.DB == nil {
 		return nil, fmt.Errorf("datastore or database connection was nil")
 	}
 
-	stmt, err := ds.DB.Prepare(query)
-	if err != nil {
-		return nil, fmt.Errorf("failed to prepare query: %w", err)
-	}
-	defer stmt.Close()
-
-	row := stmt.QueryRow(args...)
+	var row *sql.Row
+	err := instrument(context.Background(), ds, query, args, func() error {
+		stmt, err := ds.readDB().Prepare(query)
+		if err != nil {
+			return fmt.Errorf("failed to prepare query: %w", err)
+		}
+		defer stmt.Close()
 
-	return row, nil
+		row = stmt.QueryRow(args...)
+		return nil
+	})
+	return row, err
 }
 
-// Exec performs a custom SQL execution (INSERT, UPDATE, DELETE).
+// Exec performs a custom SQL execution (INSERT, UPDATE, DELETE), instrumented
+// through ds's Logger/Tracer.
 func Exec(ds *Datastore, query string, args ...any) (sql.Result, error) {
 	if ds == nil || ds.DB == nil {
 		return nil, fmt.Errorf("datastore or database connection was nil")
 	}
 
-	stmt, err := ds.DB.Prepare(query)
-	if err != nil {
-		return nil, fmt.Errorf("failed to prepare exec statement: %w", err)
-	}
-	defer stmt.Close()
+	var result sql.Result
+	err := instrument(context.Background(), ds, query, args, func() error {
+		stmt, err := ds.DB.Prepare(query)
+		if err != nil {
+			return fmt.Errorf("failed to prepare exec statement: %w", err)
+		}
+		defer stmt.Close()
 
-	result, err := stmt.Exec(args...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute exec statement: %w", err)
-	}
+		result, err = stmt.Exec(args...)
+		if err != nil {
+			return fmt.Errorf("failed to execute exec statement: %w", err)
+		}
+		return nil
+	})
+	return result, err
+}
 
-	return result, nil
+// QueryContext performs a context-aware query against any Querier (a
+// *sql.DB or an in-flight *sql.Tx), mirroring Query for transaction-aware
+// callers. Logging/tracing comes from ds, not q, so it works the same
+// whether q is ds.DB itself or a *sql.Tx opened from it.
+func QueryContext(ctx context.Context, ds *Datastore, q Querier, query string, args ...any) (*sql.Rows, error) {
+	var rows *sql.Rows
+	err := instrument(ctx, ds, query, args, func() error {
+		stmt, err := q.PrepareContext(ctx, query)
+		if err != nil {
+			return fmt.Errorf("failed to prepare query: %w", err)
+		}
+		defer stmt.Close()
+
+		rows, err = stmt.QueryContext(ctx, args...)
+		if err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+		return nil
+	})
+	return rows, err
+}
+
+// QueryRowContext performs a context-aware query for a single row against
+// any Querier, mirroring QueryRow for transaction-aware callers.
+func QueryRowContext(ctx context.Context, ds *Datastore, q Querier, query string, args ...any) (*sql.Row, error) {
+	var row *sql.Row
+	err := instrument(ctx, ds, query, args, func() error {
+		stmt, err := q.PrepareContext(ctx, query)
+		if err != nil {
+			return fmt.Errorf("failed to prepare query: %w", err)
+		}
+		defer stmt.Close()
+
+		row = stmt.QueryRowContext(ctx, args...)
+		return nil
+	})
+	return row, err
+}
+
+// ExecContext performs a context-aware execution (INSERT, UPDATE, DELETE)
+// against any Querier, mirroring Exec for transaction-aware callers.
+func ExecContext(ctx context.Context, ds *Datastore, q Querier, query string, args ...any) (sql.Result, error) {
+	var result sql.Result
+	err := instrument(ctx, ds, query, args, func() error {
+		stmt, err := q.PrepareContext(ctx, query)
+		if err != nil {
+			return fmt.Errorf("failed to prepare exec statement: %w", err)
+		}
+		defer stmt.Close()
+
+		result, err = stmt.ExecContext(ctx, args...)
+		if err != nil {
+			return fmt.Errorf("failed to execute exec statement: %w", err)
+		}
+		return nil
+	})
+	return result, err
 }
 
 // postgresResult is a custom sql.Result implementation for PostgreSQL
@@ -93,36 +171,124 @@ func Insert(ds *Datastore, model any) (sql.Result, error) {
 	}
 
 	placeholders := make([]string, len(columns))
-	for i := range columns {
+	quotedColumns := make([]string, len(columns))
+	for i, col := range columns {
 		// Index starts at 1 for SQL placeholders
 		placeholders[i] = ds.Adapter.GetPlaceholder(i + 1)
+		quotedColumns[i] = ds.Adapter.QuoteIdent(col)
 	}
 
 	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)",
-		tableName,
-		strings.Join(columns, ", "),
+		ds.Adapter.QuoteIdent(tableName),
+		strings.Join(quotedColumns, ", "),
 		strings.Join(placeholders, ", "),
 	)
 
-	// Check if the adapter is PostgresAdapter to handle ID retrieval
-	if _, ok := ds.Adapter.(*PostgresAdapter); ok {
-		if pkCol == "" {
-			// If no PK is defined, just run a standard Exec
-			return Exec(ds, query, values...)
+	// Dialects with no LastInsertId support (Postgres) append RETURNING and
+	// scan the PK back from it instead.
+	if ds.Adapter.HasReturningID() && pkCol != "" {
+		query = ds.Adapter.AppendReturning(query, pkCol)
+
+		row, err := QueryRowContext(context.Background(), ds, ds.DB, query, values...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to prepare insert query: %w", err)
+		}
+
+		var lastInsertID int64
+		if err := row.Scan(&lastInsertID); err != nil {
+			return nil, fmt.Errorf("failed to execute insert query and scan ID: %w", err)
+		}
+		_ = SetPrimaryKeyValue(model, lastInsertID)
+
+		// Return a custom result with the retrieved ID
+		return postgresResult{lastInsertID: lastInsertID, rowsAffected: 1}, nil
+	}
+
+	// For SQLite, MySQL, Oracle, and other adapters, use standard Exec.
+	result, err := Exec(ds, query, values...)
+	if err != nil {
+		return nil, err
+	}
+	if pkCol != "" {
+		if lastInsertID, err := result.LastInsertId(); err == nil {
+			_ = SetPrimaryKeyValue(model, lastInsertID)
+		}
+	}
+	return result, nil
+}
+
+// InsertContext is the context-aware equivalent of Insert. It takes a
+// Querier directly, rather than just a *Datastore, so it can run equally
+// against ds.DB itself or against a transaction's *sql.Tx; ds still supplies
+// the adapter and the Logger/Tracer instrumentation runs against.
+func InsertContext(ctx context.Context, ds *Datastore, q Querier, model any) (sql.Result, error) {
+	if ds == nil || ds.Adapter == nil || q == nil {
+		return nil, fmt.Errorf("datastore, adapter, or connection was nil")
+	}
+
+	tableName := GetTableName(model)
+	allColumns, allValues := GetFieldInfo(model)
+
+	pkCol, err := GetPrimaryKeyColumn(model)
+	// If no PK is defined, we insert all fields.
+	if err != nil {
+		pkCol = ""
+	}
+
+	columns := make([]string, 0, len(allColumns))
+	values := make([]any, 0, len(allValues))
+
+	for i, col := range allColumns {
+		if col == pkCol {
+			continue // Skip primary key for auto-increment
 		}
+		columns = append(columns, col)
+		values = append(values, allValues[i])
+	}
+
+	placeholders := make([]string, len(columns))
+	quotedColumns := make([]string, len(columns))
+	for i, col := range columns {
+		// Index starts at 1 for SQL placeholders
+		placeholders[i] = ds.Adapter.GetPlaceholder(i + 1)
+		quotedColumns[i] = ds.Adapter.QuoteIdent(col)
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)",
+		ds.Adapter.QuoteIdent(tableName),
+		strings.Join(quotedColumns, ", "),
+		strings.Join(placeholders, ", "),
+	)
 
-		query += fmt.Sprintf(" RETURNING %s", pkCol)
+	// Dialects with no LastInsertId support (Postgres) append RETURNING and
+	// scan the PK back from it instead.
+	if ds.Adapter.HasReturningID() && pkCol != "" {
+		query = ds.Adapter.AppendReturning(query, pkCol)
+
+		row, err := QueryRowContext(ctx, ds, q, query, values...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to prepare insert query: %w", err)
+		}
 
 		var lastInsertID int64
-		row := ds.DB.QueryRow(query, values...)
 		if err := row.Scan(&lastInsertID); err != nil {
 			return nil, fmt.Errorf("failed to execute insert query and scan ID: %w", err)
 		}
+		_ = SetPrimaryKeyValue(model, lastInsertID)
 
 		// Return a custom result with the retrieved ID
 		return postgresResult{lastInsertID: lastInsertID, rowsAffected: 1}, nil
 	}
 
-	// For SQLite and other adapters, use standard Exec
-	return Exec(ds, query, values...)
+	// For SQLite, MySQL, Oracle, and other adapters, use standard ExecContext.
+	result, err := ExecContext(ctx, ds, q, query, values...)
+	if err != nil {
+		return nil, err
+	}
+	if pkCol != "" {
+		if lastInsertID, err := result.LastInsertId(); err == nil {
+			_ = SetPrimaryKeyValue(model, lastInsertID)
+		}
+	}
+	return result, nil
 }
@@ -0,0 +1,562 @@
+package orm
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// supportedQuerySetOps enumerates the double-underscore lookup suffixes a
+// QuerySet understands, mirroring Django/Beego's field lookup API.
+var supportedQuerySetOps = map[string]bool{
+	"exact": true, "iexact": true, "contains": true, "icontains": true,
+	"startswith": true, "endswith": true, "istartswith": true, "iendswith": true,
+	"gt": true, "gte": true, "lt": true, "lte": true, "in": true, "isnull": true,
+}
+
+// querySetCondition is a single parsed Filter/Exclude call.
+type querySetCondition struct {
+	column  string
+	op      string
+	value   any
+	exclude bool
+}
+
+// QuerySet is a chainable, Django/Beego-style query builder layered directly
+// on a Datastore, e.g. ds.Query(&User{}).Filter("age__gt", 30).OrderBy("-age").All(&users).
+// It builds parameterized SQL via the Datastore's adapter and translates Go
+// field names to DB columns using GetFieldInfo/GetTableName, so callers no
+// longer have to hand-write SQL for non-trivial reads (see datastoreExample).
+type QuerySet struct {
+	ds         *Datastore
+	model      any
+	tableName  string
+	columns    []string
+	conditions []querySetCondition
+	order      []string
+	groupBy    []string
+	having     string
+	havingArgs []any
+	joins      []string
+	joinTables map[string]string // joined column -> joined table name, for Related
+	limit      int
+	offset     int
+	err        error
+}
+
+// Query returns a new QuerySet scoped to model's table.
+func (ds *Datastore) Query(model any) *QuerySet {
+	qs := &QuerySet{ds: ds, model: model}
+	if ds == nil || ds.DB == nil || ds.Adapter == nil {
+		qs.err = fmt.Errorf("datastore, database connection, or adapter was nil")
+		return qs
+	}
+	qs.tableName = GetTableName(model)
+	qs.columns, _ = GetFieldInfo(model)
+	return qs
+}
+
+// parseQuerySetLookup splits a "field__op" lookup into its column and
+// operator, defaulting to "exact" when no recognized double-underscore
+// suffix is present.
+func parseQuerySetLookup(lookup string) (column, op string) {
+	if idx := strings.LastIndex(lookup, "__"); idx != -1 {
+		candidate := lookup[idx+2:]
+		if supportedQuerySetOps[candidate] {
+			return strings.ToLower(lookup[:idx]), candidate
+		}
+	}
+	return strings.ToLower(lookup), "exact"
+}
+
+func (qs *QuerySet) hasColumn(column string) bool {
+	for _, c := range qs.columns {
+		if c == column {
+			return true
+		}
+	}
+	_, ok := qs.joinTables[column]
+	return ok
+}
+
+func (qs *QuerySet) addCondition(lookup string, value any, exclude bool) *QuerySet {
+	if qs.err != nil {
+		return qs
+	}
+	column, op := parseQuerySetLookup(lookup)
+	if !qs.hasColumn(column) {
+		qs.err = fmt.Errorf("liteforge: unknown field %q for table %s", column, qs.tableName)
+		return qs
+	}
+	qs.conditions = append(qs.conditions, querySetCondition{column: column, op: op, value: value, exclude: exclude})
+	return qs
+}
+
+// Filter adds a WHERE condition. lookup is a column name, optionally suffixed
+// with "__<op>" (e.g. "age__gt", "name__icontains", "status__in").
+func (qs *QuerySet) Filter(lookup string, value any) *QuerySet {
+	return qs.addCondition(lookup, value, false)
+}
+
+// Exclude adds a negated WHERE condition, the inverse of Filter.
+func (qs *QuerySet) Exclude(lookup string, value any) *QuerySet {
+	return qs.addCondition(lookup, value, true)
+}
+
+// OrderBy sets the ORDER BY clause. Prefix a field with "-" for descending order.
+func (qs *QuerySet) OrderBy(fields ...string) *QuerySet {
+	if qs.err != nil {
+		return qs
+	}
+	for _, f := range fields {
+		dir := "ASC"
+		column := f
+		if strings.HasPrefix(f, "-") {
+			dir = "DESC"
+			column = f[1:]
+		}
+		column = strings.ToLower(column)
+		if !qs.hasColumn(column) {
+			qs.err = fmt.Errorf("liteforge: unknown field %q for table %s", column, qs.tableName)
+			return qs
+		}
+		qs.order = append(qs.order, qs.qualify(column)+" "+dir)
+	}
+	return qs
+}
+
+// GroupBy sets the GROUP BY clause, for use with Having and aggregate columns.
+func (qs *QuerySet) GroupBy(fields ...string) *QuerySet {
+	if qs.err != nil {
+		return qs
+	}
+	for _, f := range fields {
+		column := strings.ToLower(f)
+		if !qs.hasColumn(column) {
+			qs.err = fmt.Errorf("liteforge: unknown field %q for table %s", column, qs.tableName)
+			return qs
+		}
+		qs.groupBy = append(qs.groupBy, qs.qualify(column))
+	}
+	return qs
+}
+
+// Having adds a HAVING condition, e.g. Having("COUNT(*) > ?", 5). Unlike
+// Filter, condition is a raw SQL fragment (using "?" as a generic
+// placeholder) since HAVING typically guards an aggregate rather than a
+// single column. Repeated calls are ANDed together.
+func (qs *QuerySet) Having(condition string, args ...any) *QuerySet {
+	if qs.err != nil {
+		return qs
+	}
+	if qs.having != "" {
+		qs.having += " AND "
+	}
+	qs.having += condition
+	qs.havingArgs = append(qs.havingArgs, args...)
+	return qs
+}
+
+// Related adds an INNER JOIN for a belongs-to relation field (tagged
+// `liteforge:"fk=..."`), so Filter/OrderBy/GroupBy can reach across it.
+func (qs *QuerySet) Related(fieldName string) *QuerySet {
+	if qs.err != nil {
+		return qs
+	}
+	rel, ok := GetRelations(qs.model)[fieldName]
+	if !ok || rel.Kind != RelationBelongsTo {
+		qs.err = fmt.Errorf("liteforge: %q is not a belongs-to relation on %s", fieldName, qs.tableName)
+		return qs
+	}
+
+	t := reflect.TypeOf(qs.model)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	field, _ := t.FieldByName(fieldName)
+	relType := field.Type
+	if relType.Kind() == reflect.Ptr {
+		relType = relType.Elem()
+	}
+	relModel := reflect.New(relType).Interface()
+	relTable := GetTableName(relModel)
+	relPK, err := GetPrimaryKeyColumn(relModel)
+	if err != nil {
+		qs.err = fmt.Errorf("liteforge: related model %s has no primary key: %w", relTable, err)
+		return qs
+	}
+
+	adapter := qs.ds.Adapter
+	qs.joins = append(qs.joins, fmt.Sprintf("JOIN %s ON %s.%s = %s.%s",
+		adapter.QuoteIdent(relTable),
+		adapter.QuoteIdent(relTable), adapter.QuoteIdent(relPK),
+		adapter.QuoteIdent(qs.tableName), adapter.QuoteIdent(rel.FKColumn),
+	))
+
+	if qs.joinTables == nil {
+		qs.joinTables = make(map[string]string)
+	}
+	relColumns, _ := GetFieldInfo(relModel)
+	for _, col := range relColumns {
+		if !qs.hasColumn(col) { // base table's own columns take priority
+			qs.joinTables[col] = relTable
+		}
+	}
+	return qs
+}
+
+// qualify resolves column to its fully-qualified, quoted SQL reference: the
+// joined table's if Related brought it in, otherwise the base table's once
+// joins are in play (to avoid ambiguity), otherwise just the bare column.
+func (qs *QuerySet) qualify(column string) string {
+	adapter := qs.ds.Adapter
+	if table, ok := qs.joinTables[column]; ok {
+		return adapter.QuoteIdent(table) + "." + adapter.QuoteIdent(column)
+	}
+	if len(qs.joins) == 0 {
+		return adapter.QuoteIdent(column)
+	}
+	return adapter.QuoteIdent(qs.tableName) + "." + adapter.QuoteIdent(column)
+}
+
+// Limit caps the number of rows returned.
+func (qs *QuerySet) Limit(n int) *QuerySet {
+	qs.limit = n
+	return qs
+}
+
+// Offset skips the first n matching rows.
+func (qs *QuerySet) Offset(n int) *QuerySet {
+	qs.offset = n
+	return qs
+}
+
+// escapeQuerySetLike escapes LIKE metacharacters ('%', '_') in a
+// caller-supplied value so they're matched literally; the caller then
+// appends the wildcards appropriate for contains/startswith/endswith.
+func escapeQuerySetLike(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+	return replacer.Replace(value)
+}
+
+// buildCondition renders a single condition to a SQL fragment (using "?" as
+// a generic placeholder marker) plus its bind arguments.
+func (qs *QuerySet) buildCondition(c querySetCondition) (string, []any, error) {
+	adapter := qs.ds.Adapter
+	column := qs.qualify(c.column)
+
+	var clause string
+	var args []any
+	switch c.op {
+	case "in":
+		values := reflect.ValueOf(c.value)
+		if values.Kind() != reflect.Slice {
+			return "", nil, fmt.Errorf("liteforge: %s__in requires a slice value", c.column)
+		}
+		n := values.Len()
+		placeholders := make([]string, n)
+		args = make([]any, n)
+		for i := 0; i < n; i++ {
+			placeholders[i] = "?"
+			args[i] = values.Index(i).Interface()
+		}
+		clause = fmt.Sprintf("%s IN (%s)", column, strings.Join(placeholders, ", "))
+	case "isnull":
+		if want, _ := c.value.(bool); want {
+			clause = column + " IS NULL"
+		} else {
+			clause = column + " IS NOT NULL"
+		}
+	case "contains", "icontains":
+		clause = adapter.OperatorSQL(c.op, column) + ` ESCAPE '\'`
+		args = []any{"%" + escapeQuerySetLike(fmt.Sprintf("%v", c.value)) + "%"}
+	case "startswith", "istartswith":
+		clause = adapter.OperatorSQL(c.op, column) + ` ESCAPE '\'`
+		args = []any{escapeQuerySetLike(fmt.Sprintf("%v", c.value)) + "%"}
+	case "endswith", "iendswith":
+		clause = adapter.OperatorSQL(c.op, column) + ` ESCAPE '\'`
+		args = []any{"%" + escapeQuerySetLike(fmt.Sprintf("%v", c.value))}
+	default:
+		clause = adapter.OperatorSQL(c.op, column)
+		args = []any{c.value}
+	}
+
+	if c.exclude {
+		clause = "NOT (" + clause + ")"
+	}
+	return clause, args, nil
+}
+
+// rebindPlaceholders replaces sequential "?" markers in sqlFragment with the
+// adapter's real placeholder syntax (e.g. "$1", "$2" for Postgres), starting
+// the count at startIndex.
+func (qs *QuerySet) rebindPlaceholders(sqlFragment string, startIndex int) string {
+	adapter := qs.ds.Adapter
+	var b strings.Builder
+	idx := startIndex
+	for _, r := range sqlFragment {
+		if r == '?' {
+			b.WriteString(adapter.GetPlaceholder(idx))
+			idx++
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// buildWhere renders every condition, ANDed together, rebinding generic "?"
+// placeholders to the adapter's real syntax starting at startIndex.
+func (qs *QuerySet) buildWhere(startIndex int) (string, []any, error) {
+	if len(qs.conditions) == 0 {
+		return "", nil, nil
+	}
+	var clauses []string
+	var args []any
+	for _, c := range qs.conditions {
+		clause, clauseArgs, err := qs.buildCondition(c)
+		if err != nil {
+			return "", nil, err
+		}
+		clauses = append(clauses, clause)
+		args = append(args, clauseArgs...)
+	}
+	where := qs.rebindPlaceholders(strings.Join(clauses, " AND "), startIndex)
+	return "WHERE " + where, args, nil
+}
+
+// selectQuery renders the SELECT statement (without LIMIT/OFFSET arithmetic
+// applied to args) along with its bind args, shared by All/One/Count/Exists.
+func (qs *QuerySet) selectQuery(selectList string, startIndex int) (string, []any, error) {
+	adapter := qs.ds.Adapter
+	query := fmt.Sprintf("SELECT %s FROM %s", selectList, adapter.QuoteIdent(qs.tableName))
+	for _, join := range qs.joins {
+		query += " " + join
+	}
+
+	where, args, err := qs.buildWhere(startIndex)
+	if err != nil {
+		return "", nil, err
+	}
+	if where != "" {
+		query += " " + where
+	}
+	if len(qs.groupBy) > 0 {
+		query += " GROUP BY " + strings.Join(qs.groupBy, ", ")
+	}
+	if qs.having != "" {
+		having := qs.rebindPlaceholders(qs.having, startIndex+len(args))
+		query += " HAVING " + having
+		args = append(args, qs.havingArgs...)
+	}
+	return query, args, nil
+}
+
+// All executes the query and scans every matching row into dest, which must
+// be a pointer to a slice of structs (or pointers to structs).
+func (qs *QuerySet) All(dest any) error {
+	if qs.err != nil {
+		return qs.err
+	}
+	sliceVal := reflect.ValueOf(dest)
+	if sliceVal.Kind() != reflect.Ptr || sliceVal.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("liteforge: All requires a pointer to a slice")
+	}
+	sliceElem := sliceVal.Elem()
+	elemType := sliceElem.Type().Elem()
+	isPtr := elemType.Kind() == reflect.Ptr
+	structType := elemType
+	if isPtr {
+		structType = structType.Elem()
+	}
+
+	adapter := qs.ds.Adapter
+	selectList := quoteIdentList(adapter, qs.tableName, qs.columns)
+	query, args, err := qs.selectQuery(selectList, 1)
+	if err != nil {
+		return err
+	}
+	if len(qs.order) > 0 {
+		query += " ORDER BY " + strings.Join(qs.order, ", ")
+	}
+	if qs.limit > 0 {
+		query += " LIMIT " + strconv.Itoa(qs.limit)
+	}
+	if qs.offset > 0 {
+		query += " OFFSET " + strconv.Itoa(qs.offset)
+	}
+
+	rows, err := Query(qs.ds, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to query rows: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		elemPtr := reflect.New(structType)
+		scanDest, err := querySetScanDestinations(elemPtr.Elem(), qs.columns)
+		if err != nil {
+			return err
+		}
+		if err := rows.Scan(scanDest...); err != nil {
+			return fmt.Errorf("failed to scan row: %w", err)
+		}
+		if isPtr {
+			sliceElem.Set(reflect.Append(sliceElem, elemPtr))
+		} else {
+			sliceElem.Set(reflect.Append(sliceElem, elemPtr.Elem()))
+		}
+	}
+	return rows.Err()
+}
+
+// One executes the query and scans the first matching row into dest, which
+// must be a pointer to a struct. It returns sql.ErrNoRows if nothing matches.
+func (qs *QuerySet) One(dest any) error {
+	if qs.err != nil {
+		return qs.err
+	}
+	qs.limit = 1
+	sliceType := reflect.SliceOf(reflect.TypeOf(dest).Elem())
+	slicePtr := reflect.New(sliceType)
+	if err := qs.All(slicePtr.Interface()); err != nil {
+		return err
+	}
+	slice := slicePtr.Elem()
+	if slice.Len() == 0 {
+		return sql.ErrNoRows
+	}
+	reflect.ValueOf(dest).Elem().Set(slice.Index(0))
+	return nil
+}
+
+// Count returns the number of rows matching the QuerySet's conditions.
+func (qs *QuerySet) Count() (int64, error) {
+	if qs.err != nil {
+		return 0, qs.err
+	}
+	query, args, err := qs.selectQuery("COUNT(*)", 1)
+	if err != nil {
+		return 0, err
+	}
+	row, err := QueryRow(qs.ds, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query count: %w", err)
+	}
+	var count int64
+	if err := row.Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to scan count: %w", err)
+	}
+	return count, nil
+}
+
+// Exists reports whether any row matches the QuerySet's conditions.
+func (qs *QuerySet) Exists() (bool, error) {
+	count, err := qs.Count()
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// Delete removes every row matching the QuerySet's conditions.
+func (qs *QuerySet) Delete() (sql.Result, error) {
+	if qs.err != nil {
+		return nil, qs.err
+	}
+	where, args, err := qs.buildWhere(1)
+	if err != nil {
+		return nil, err
+	}
+	query := "DELETE FROM " + qs.ds.Adapter.QuoteIdent(qs.tableName)
+	if where != "" {
+		query += " " + where
+	}
+	return Exec(qs.ds, query, args...)
+}
+
+// Update sets the given columns on every row matching the QuerySet's conditions.
+func (qs *QuerySet) Update(values map[string]any) (sql.Result, error) {
+	if qs.err != nil {
+		return nil, qs.err
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("liteforge: Update requires at least one column")
+	}
+
+	columns := make([]string, 0, len(values))
+	for col := range values {
+		columns = append(columns, col)
+	}
+	sort.Strings(columns) // deterministic SET clause / placeholder ordering
+
+	adapter := qs.ds.Adapter
+	setClauses := make([]string, len(columns))
+	args := make([]any, len(columns))
+	for i, col := range columns {
+		if !qs.hasColumn(col) {
+			return nil, fmt.Errorf("liteforge: unknown field %q for table %s", col, qs.tableName)
+		}
+		setClauses[i] = adapter.QuoteIdent(col) + " = ?"
+		args[i] = values[col]
+	}
+
+	where, whereArgs, err := qs.buildWhere(len(columns) + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	setClause := qs.rebindPlaceholders(strings.Join(setClauses, ", "), 1)
+	query := fmt.Sprintf("UPDATE %s SET %s", adapter.QuoteIdent(qs.tableName), setClause)
+	if where != "" {
+		query += " " + where
+	}
+
+	return Exec(qs.ds, query, append(args, whereArgs...)...)
+}
+
+// quoteIdentList quotes each column in names, always qualified with table,
+// and joins them for a SELECT column list.
+func quoteIdentList(adapter DBAdapter, table string, names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = adapter.QuoteIdent(table) + "." + adapter.QuoteIdent(name)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// querySetScanDestinations builds a []any of scan targets for elem (a struct
+// value, not a pointer) parallel to columns. Belongs-to relation fields
+// don't hold their FK column directly (they hold a pointer to the related
+// struct, which Scan can't populate), so their FK value is scanned into a
+// throwaway destination; use Related to join across it instead.
+func querySetScanDestinations(elem reflect.Value, columns []string) ([]any, error) {
+	t := elem.Type()
+	relations := GetRelations(elem.Addr().Interface())
+
+	fkColumns := make(map[string]bool, len(relations))
+	for _, rel := range relations {
+		if rel.Kind == RelationBelongsTo {
+			fkColumns[rel.FKColumn] = true
+		}
+	}
+
+	dest := make([]any, len(columns))
+	for i, col := range columns {
+		if fkColumns[col] {
+			dest[i] = new(sql.NullInt64)
+			continue
+		}
+		field, ok := t.FieldByNameFunc(func(name string) bool {
+			return strings.ToLower(name) == col
+		})
+		if !ok {
+			return nil, fmt.Errorf("liteforge: no field for column %q on %s", col, t.Name())
+		}
+		dest[i] = elem.FieldByIndex(field.Index).Addr().Interface()
+	}
+	return dest, nil
+}
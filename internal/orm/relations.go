@@ -0,0 +1,126 @@
+package orm
+
+import (
+	"reflect"
+	"strings"
+)
+
+// RelationKind identifies which kind of association a `liteforge:"..."`
+// struct tag describes.
+type RelationKind int
+
+const (
+	// RelationNone means the field is a plain column, not a relation.
+	RelationNone RelationKind = iota
+	// RelationBelongsTo is a single related struct whose primary key is
+	// stored in this model's own FK column, e.g. `User *User `liteforge:"fk=user_id"``.
+	RelationBelongsTo
+	// RelationHasMany is a slice of related structs that store this model's
+	// primary key in their own FK column, e.g. `Comments []Comment `liteforge:"hasmany,fk=post_id"``.
+	RelationHasMany
+	// RelationManyToMany is a slice of related structs linked through a join
+	// table, e.g. `Tags []Tag `liteforge:"m2m=post_tags"``.
+	RelationManyToMany
+)
+
+// RelationInfo describes a single relation field parsed from a `liteforge` tag.
+type RelationInfo struct {
+	Kind      RelationKind
+	FieldName string // Go struct field name, e.g. "Comments"
+	FKColumn  string // foreign key column name, for BelongsTo/HasMany
+	JoinTable string // join table name, for ManyToMany
+}
+
+// parseRelationTag parses the value of a `liteforge:"..."` struct tag into a
+// RelationInfo. ok is false when tag does not describe a relation (e.g. it is
+// empty, or only contains unrelated directives like "pk").
+func parseRelationTag(tag string) (info RelationInfo, ok bool) {
+	if tag == "" {
+		return RelationInfo{}, false
+	}
+
+	hasMany := false
+	for _, part := range strings.Split(tag, ",") {
+		part = strings.TrimSpace(part)
+		switch {
+		case part == "hasmany":
+			hasMany = true
+		case strings.HasPrefix(part, "fk="):
+			info.FKColumn = strings.TrimPrefix(part, "fk=")
+		case strings.HasPrefix(part, "m2m="):
+			info.JoinTable = strings.TrimPrefix(part, "m2m=")
+		}
+	}
+
+	switch {
+	case info.JoinTable != "":
+		info.Kind = RelationManyToMany
+		return info, true
+	case hasMany && info.FKColumn != "":
+		info.Kind = RelationHasMany
+		return info, true
+	case info.FKColumn != "":
+		info.Kind = RelationBelongsTo
+		return info, true
+	default:
+		return RelationInfo{}, false
+	}
+}
+
+// GetRelations returns every relation field declared on model's struct type,
+// keyed by Go field name (e.g. "Comments", "Tags").
+func GetRelations(model any) map[string]RelationInfo {
+	t := reflect.TypeOf(model)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	relations := make(map[string]RelationInfo)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		info, ok := parseRelationTag(field.Tag.Get("liteforge"))
+		if !ok {
+			continue
+		}
+		info.FieldName = field.Name
+		relations[field.Name] = info
+	}
+	return relations
+}
+
+// IndexField describes a column-level index directive parsed from a
+// `liteforge:"index"` / `liteforge:"unique"` struct tag.
+type IndexField struct {
+	Column string
+	Unique bool
+}
+
+// GetIndexFields returns the index directives declared on model's fields,
+// in struct field order. It reads the same `liteforge` tag namespace as
+// GetRelations but looks for "index"/"unique" rather than relation
+// directives, so a plain column field can carry either without conflict.
+func GetIndexFields(model any) []IndexField {
+	t := reflect.TypeOf(model)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	var fields []IndexField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		indexed, unique := false, false
+		for _, part := range strings.Split(field.Tag.Get("liteforge"), ",") {
+			switch strings.TrimSpace(part) {
+			case "index":
+				indexed = true
+			case "unique":
+				indexed = true
+				unique = true
+			}
+		}
+		if indexed {
+			fields = append(fields, IndexField{Column: strings.ToLower(field.Name), Unique: unique})
+		}
+	}
+	return fields
+}
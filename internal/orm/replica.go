@@ -0,0 +1,137 @@
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// replicaConn is one read replica tracked by a ReplicaPool, along with
+// whether its last health check succeeded.
+type replicaConn struct {
+	db  *sql.DB
+	dsn string
+
+	mu      sync.Mutex
+	healthy bool
+}
+
+func (rc *replicaConn) setHealthy(healthy bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.healthy = healthy
+}
+
+func (rc *replicaConn) isHealthy() bool {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.healthy
+}
+
+// ReplicaPool round-robins read traffic across a set of replica
+// connections, skipping any a health check has marked unhealthy. It is
+// built by OpenDB from Config.ReadReplicas and attached to Datastore.Replicas;
+// callers don't construct one directly.
+type ReplicaPool struct {
+	mu       sync.RWMutex
+	replicas []*replicaConn
+	counter  uint64
+}
+
+// newReplicaPool opens one connection per dsn using adapter's dialect rules
+// (so charset/collation, TLS, etc. match the primary), marking each healthy
+// until the first health check says otherwise.
+func newReplicaPool(adapter DBAdapter, cfg Config, dsns []string) (*ReplicaPool, error) {
+	pool := &ReplicaPool{}
+	for _, dsn := range dsns {
+		replicaCfg := cfg
+		replicaCfg.DataSourceName = dsn
+		db, err := adapter.Connect(replicaCfg)
+		if err != nil {
+			return nil, err
+		}
+		rc := &replicaConn{db: db, dsn: dsn, healthy: true}
+		pool.replicas = append(pool.replicas, rc)
+	}
+	return pool, nil
+}
+
+// Next returns the next healthy replica connection in round-robin order, or
+// nil if every replica is currently unhealthy (callers should fall back to
+// the primary in that case).
+func (p *ReplicaPool) Next() *sql.DB {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	n := len(p.replicas)
+	if n == 0 {
+		return nil
+	}
+
+	start := atomic.AddUint64(&p.counter, 1)
+	for i := 0; i < n; i++ {
+		rc := p.replicas[(int(start)+i)%n]
+		if rc.isHealthy() {
+			return rc.db
+		}
+	}
+	return nil
+}
+
+// StartHealthChecks pings every replica on interval, marking it unhealthy
+// (so Next skips it) on failure and healthy again once it responds, until
+// the returned stop func is called.
+func (p *ReplicaPool) StartHealthChecks(interval time.Duration) (stop func()) {
+	done := make(chan struct{})
+	ticker := time.NewTicker(interval)
+
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				p.CheckNow()
+			case <-done:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() { once.Do(func() { close(done) }) }
+}
+
+// CheckNow pings every tracked replica immediately and updates its healthy
+// flag, without waiting for StartHealthChecks' next tick.
+func (p *ReplicaPool) CheckNow() {
+	p.mu.RLock()
+	replicas := append([]*replicaConn(nil), p.replicas...)
+	p.mu.RUnlock()
+
+	for _, rc := range replicas {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		err := rc.db.PingContext(ctx)
+		cancel()
+		rc.setHealthy(err == nil)
+	}
+}
+
+// readDB returns the *sql.DB read-only queries should run against: a
+// round-robin replica if ds.Replicas has a healthy one, otherwise ds.DB.
+func (ds *Datastore) readDB() *sql.DB {
+	if ds.Replicas != nil {
+		if db := ds.Replicas.Next(); db != nil {
+			return db
+		}
+	}
+	return ds.DB
+}
+
+// Ping verifies the primary connection is reachable, honoring ctx's
+// cancellation/deadline. Replica connectivity is tracked separately by the
+// background health checker started from Config.ReadReplicas.
+func (ds *Datastore) Ping(ctx context.Context) error {
+	return ds.DB.PingContext(ctx)
+}
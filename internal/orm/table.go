@@ -1,13 +1,14 @@
 package orm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
 	"strings"
 )
 
-// getTableName extracts the table name from a struct type using reflection.
+// GetTableName extracts the table name from a struct type using reflection.
 func GetTableName(model any) string {
 	t := reflect.TypeOf(model)
 	if t.Kind() == reflect.Ptr {
@@ -19,14 +20,20 @@ func GetTableName(model any) string {
 	return strings.ToLower(t.Name()) // Convert to lowercase as a convention
 }
 
-// getFieldInfo extracts field information from a struct using reflection.
+// GetFieldInfo extracts field information from a struct using reflection.
 // It returns slices of column names and placeholders for use in SQL queries.
+//
+// Relation-typed fields (tagged `liteforge:"hasmany,..."` or `liteforge:"m2m=..."`)
+// are not real columns and are excluded entirely. A belongs-to field
+// (`liteforge:"fk=..."`) contributes its FK column instead of its own field
+// name, with the value taken from the related struct's primary key.
 func GetFieldInfo(model any) ([]string, []interface{}) {
 	val := reflect.ValueOf(model)
 	if val.Kind() == reflect.Ptr {
 		val = val.Elem()
 	}
 	t := val.Type()
+	relations := GetRelations(model)
 
 	numFields := val.NumField()
 	columns := make([]string, 0, numFields)
@@ -34,6 +41,18 @@ func GetFieldInfo(model any) ([]string, []interface{}) {
 
 	for i := 0; i < numFields; i++ {
 		field := t.Field(i)
+
+		if rel, ok := relations[field.Name]; ok {
+			switch rel.Kind {
+			case RelationHasMany, RelationManyToMany:
+				continue // not real columns
+			case RelationBelongsTo:
+				columns = append(columns, rel.FKColumn)
+				values = append(values, belongsToFKValue(val.Field(i)))
+				continue
+			}
+		}
+
 		columnName := strings.ToLower(field.Name) // Default column name
 
 		// // Check for a `db` tag to customize the column name.
@@ -48,6 +67,19 @@ func GetFieldInfo(model any) ([]string, []interface{}) {
 	return columns, values
 }
 
+// belongsToFKValue resolves the FK column value for a belongs-to field: the
+// related struct's primary key, or nil if the pointer is unset.
+func belongsToFKValue(fieldVal reflect.Value) any {
+	if fieldVal.Kind() != reflect.Ptr || fieldVal.IsNil() {
+		return nil
+	}
+	pk, err := GetPrimaryKeyValue(fieldVal.Interface())
+	if err != nil {
+		return nil
+	}
+	return pk
+}
+
 // GetPrimaryKeyColumn extracts the primary key column name from a struct type.
 func GetPrimaryKeyColumn(model any) (string, error) {
 	val := reflect.ValueOf(model)
@@ -68,6 +100,57 @@ func GetPrimaryKeyColumn(model any) (string, error) {
 	return "", errors.New("model has no primary key field (tag: `pk:\"true\"`)")
 }
 
+// GetPrimaryKeyValue extracts the value of model's primary key field (tagged
+// `pk:"true"`) using reflection.
+func GetPrimaryKeyValue(model any) (any, error) {
+	val := reflect.ValueOf(model)
+	if val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+	t := val.Type()
+
+	for i := 0; i < val.NumField(); i++ {
+		field := t.Field(i)
+		pkTag := field.Tag.Get("pk")
+		if pkTag == "true" {
+			return val.Field(i).Interface(), nil
+		}
+	}
+
+	return nil, errors.New("model has no primary key field (tag: `pk:\"true\"`)")
+}
+
+// SetPrimaryKeyValue sets model's primary key field (tagged `pk:"true"`) to
+// value using reflection, converting value to the field's type if it's a
+// numeric kind (e.g. the int64 an auto-increment insert reports back as a
+// plain int PK field). model must be a pointer to a struct.
+func SetPrimaryKeyValue(model any, value any) error {
+	val := reflect.ValueOf(model)
+	if val.Kind() != reflect.Ptr {
+		return errors.New("model must be a pointer to set its primary key")
+	}
+	val = val.Elem()
+	t := val.Type()
+
+	for i := 0; i < val.NumField(); i++ {
+		field := t.Field(i)
+		pkTag := field.Tag.Get("pk")
+		if pkTag != "true" {
+			continue
+		}
+
+		fv := val.Field(i)
+		rv := reflect.ValueOf(value)
+		if rv.Type().ConvertibleTo(fv.Type()) {
+			fv.Set(rv.Convert(fv.Type()))
+			return nil
+		}
+		return fmt.Errorf("cannot assign %T to primary key field %s of type %s", value, field.Name, fv.Type())
+	}
+
+	return errors.New("model has no primary key field (tag: `pk:\"true\"`)")
+}
+
 // CreateTable creates a database table based on the provided model using the Datastore's adapter.
 func CreateTable(ds *Datastore, model any) error {
 
@@ -81,7 +164,10 @@ func CreateTable(ds *Datastore, model any) error {
 		return fmt.Errorf("failed to generate create table SQL: %w", err)
 	}
 
-	_, err = ds.DB.Exec(createQuery)
+	err = instrument(context.Background(), ds, createQuery, nil, func() error {
+		_, err := ds.DB.Exec(createQuery)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("failed to execute create table query: %w", err)
 	}
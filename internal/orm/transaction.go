@@ -1,6 +1,7 @@
 package orm
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 )
@@ -12,3 +13,15 @@ func BeginTx(ds *Datastore) (*sql.Tx, error) {
 	}
 	return ds.Adapter.BeginTx(ds.DB)
 }
+
+// BeginTxContext is the context-aware equivalent of BeginTx: the returned
+// Tx's methods honor ctx's cancellation/deadline instead of running to
+// completion regardless. Every adapter's BeginTx is a thin wrapper around
+// database/sql with no dialect-specific behavior, so this calls ds.DB's own
+// BeginTx directly rather than routing through the adapter.
+func BeginTxContext(ctx context.Context, ds *Datastore) (*sql.Tx, error) {
+	if ds == nil || ds.DB == nil {
+		return nil, fmt.Errorf("datastore or database connection was nil")
+	}
+	return ds.DB.BeginTx(ctx, nil)
+}
@@ -0,0 +1,244 @@
+package orm
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ValidationError reports every `validate` tag rule (and Validator.Validate
+// failure) that failed for a model, keyed by struct field name, so HTTP
+// handlers can render per-field messages instead of a single opaque error.
+// Non-field errors (from the optional Validate() method) are collected
+// under modelErrorKey.
+type ValidationError struct {
+	Fields map[string][]string
+}
+
+// modelErrorKey collects errors from a model's own Validate() method, which
+// aren't tied to a single struct field.
+const modelErrorKey = "_"
+
+func (e *ValidationError) Error() string {
+	fields := make([]string, 0, len(e.Fields))
+	for field := range e.Fields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var b strings.Builder
+	b.WriteString("liteforge: validation failed")
+	for _, field := range fields {
+		for _, msg := range e.Fields[field] {
+			if field == modelErrorKey {
+				fmt.Fprintf(&b, "; %s", msg)
+			} else {
+				fmt.Fprintf(&b, "; %s: %s", field, msg)
+			}
+		}
+	}
+	return b.String()
+}
+
+func (e *ValidationError) addField(field, msg string) {
+	if e.Fields == nil {
+		e.Fields = make(map[string][]string)
+	}
+	e.Fields[field] = append(e.Fields[field], msg)
+}
+
+// Validator is the optional interface a model implements for validation
+// rules a `validate` struct tag can't express (cross-field checks, lookups
+// against other data, etc). Reflection-driven tag rules run first; Validate
+// runs afterwards and only if those passed.
+type Validator interface {
+	Validate() error
+}
+
+// ValidatorFunc implements a single named `validate` tag rule. value is the
+// struct field's runtime value; param is the text after "=" in the tag
+// (e.g. "3" for "min=3"), empty for parameterless rules like "required".
+// It returns a user-facing message describing the failure, or nil if value
+// satisfies the rule.
+type ValidatorFunc func(value any, param string) error
+
+// validators holds the built-in rules plus any registered via
+// RegisterValidator, keyed by the name used in a `validate` tag.
+var validators = map[string]ValidatorFunc{
+	"required":     validateRequired,
+	"min":          validateMin,
+	"max":          validateMax,
+	"regex":        validateRegex,
+	"alphanumeric": validateAlphanumeric,
+	"email":        validateEmail,
+	"url":          validateURL,
+}
+
+// RegisterValidator adds a named rule usable in `validate` struct tags
+// (e.g. RegisterValidator("usstate", isUSState) enables `validate:"usstate"`),
+// overwriting any existing rule of the same name. Not safe to call
+// concurrently with validation.
+func RegisterValidator(name string, fn ValidatorFunc) {
+	validators[name] = fn
+}
+
+// Validate runs every `validate` tag rule declared on model's fields, then
+// its Validate() method if it implements Validator. All tag failures are
+// collected (rather than stopping at the first) so a ValidationError can
+// report every invalid field at once; a nil return means model is valid.
+func Validate(model any) error {
+	val := reflect.ValueOf(model)
+	if val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return nil
+	}
+	t := val.Type()
+
+	verr := &ValidationError{}
+	for i := 0; i < val.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("validate")
+		if tag == "" {
+			continue
+		}
+		value := val.Field(i).Interface()
+		for _, rule := range strings.Split(tag, ",") {
+			rule = strings.TrimSpace(rule)
+			if rule == "" {
+				continue
+			}
+			name, param := rule, ""
+			if idx := strings.Index(rule, "="); idx != -1 {
+				name, param = rule[:idx], rule[idx+1:]
+			}
+			fn, ok := validators[name]
+			if !ok {
+				verr.addField(field.Name, fmt.Sprintf("unknown validator %q", name))
+				continue
+			}
+			if err := fn(value, param); err != nil {
+				verr.addField(field.Name, err.Error())
+			}
+		}
+	}
+
+	if len(verr.Fields) > 0 {
+		return verr
+	}
+
+	if validator, ok := model.(Validator); ok {
+		if err := validator.Validate(); err != nil {
+			verr.addField(modelErrorKey, err.Error())
+			return verr
+		}
+	}
+
+	return nil
+}
+
+func validateRequired(value any, _ string) error {
+	if reflect.ValueOf(value).IsZero() {
+		return fmt.Errorf("is required")
+	}
+	return nil
+}
+
+// numericLength returns a comparable length/magnitude for min/max: the rune
+// count for a string, or the value itself for a number.
+func numericLength(value any) (float64, bool) {
+	switch v := value.(type) {
+	case string:
+		return float64(len([]rune(v))), true
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+func validateMin(value any, param string) error {
+	want, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return fmt.Errorf("invalid min parameter %q", param)
+	}
+	got, ok := numericLength(value)
+	if !ok {
+		return fmt.Errorf("min is not supported for this field's type")
+	}
+	if got < want {
+		return fmt.Errorf("must be at least %s", param)
+	}
+	return nil
+}
+
+func validateMax(value any, param string) error {
+	want, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return fmt.Errorf("invalid max parameter %q", param)
+	}
+	got, ok := numericLength(value)
+	if !ok {
+		return fmt.Errorf("max is not supported for this field's type")
+	}
+	if got > want {
+		return fmt.Errorf("must be at most %s", param)
+	}
+	return nil
+}
+
+func validateRegex(value any, param string) error {
+	re, err := regexp.Compile(param)
+	if err != nil {
+		return fmt.Errorf("invalid regex parameter %q", param)
+	}
+	if !re.MatchString(fmt.Sprintf("%v", value)) {
+		return fmt.Errorf("does not match pattern %q", param)
+	}
+	return nil
+}
+
+// validateAlphanumeric reuses the same alphaNumericRegex
+// ValidateAndSanitizeAlphaNumeric already checks input against, so the two
+// don't drift.
+func validateAlphanumeric(value any, _ string) error {
+	if !alphaNumericRegex.MatchString(fmt.Sprintf("%v", value)) {
+		return fmt.Errorf("must contain only letters, numbers, and underscores")
+	}
+	return nil
+}
+
+func validateEmail(value any, _ string) error {
+	s := fmt.Sprintf("%v", value)
+	if _, err := mail.ParseAddress(s); err != nil {
+		return fmt.Errorf("must be a valid email address")
+	}
+	return nil
+}
+
+func validateURL(value any, _ string) error {
+	s := fmt.Sprintf("%v", value)
+	u, err := url.ParseRequestURI(s)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("must be a valid URL")
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package liteforge
 
 import (
+	"github.com/pballentine13/liteforge/internal/migrate"
 	"github.com/pballentine13/liteforge/internal/orm"
 	"github.com/pballentine13/liteforge/pkg/model"
 )
@@ -8,6 +9,66 @@ import (
 type Config = orm.Config
 type Datastore = orm.Datastore
 
+// Migrator is the schema migration API returned by Datastore.Migrator().
+type Migrator = orm.Migrator
+
+// Migration is one versioned, reversible schema change registered with
+// Migrate/MigrateTo.
+type Migration = migrate.Migration
+
+// MigrationStatusResult reports which migrations have run and which
+// haven't, as returned by MigrationStatus.
+type MigrationStatusResult = migrate.Status
+
+// MigrationRegistry accumulates versioned migrations via Register and runs
+// them as a set through MigrateUp/MigrateDown/Status.
+type MigrationRegistry = migrate.Registry
+
+// NewMigrationRegistry returns an empty MigrationRegistry ready for Register calls.
+var NewMigrationRegistry = migrate.NewRegistry
+
+// AutoMigrate reconciles each model's table with ds's live schema, via
+// ds.Migrator().AutoMigrate.
+var AutoMigrate = migrate.AutoMigrate
+
+// ColumnInfo describes a single introspected database column.
+type ColumnInfo = orm.ColumnInfo
+
+// ConnectionParams is the structured alternative to Config.DataSourceName,
+// consumed by BuildDSN.
+type ConnectionParams = orm.ConnectionParams
+
+// ReplicaPool round-robins read queries across Config.ReadReplicas, as
+// attached to Datastore.Replicas by OpenDB.
+type ReplicaPool = orm.ReplicaPool
+
+// GCOptions configures StartGC's sweep interval, chunk size, and observer.
+type GCOptions = orm.GCOptions
+
+// GCObserver receives one call per table on every GC sweep pass.
+type GCObserver = orm.GCObserver
+
+// Logger receives one call per SQL statement a Datastore executes.
+type Logger = orm.Logger
+
+// Tracer optionally emits a per-statement span, e.g. via OpenTelemetry.
+type Tracer = orm.Tracer
+
+// DefaultLogger is the stdout Logger used when Config.Logger is unset.
+type DefaultLogger = orm.DefaultLogger
+
+// ValidationError reports every failed `validate` tag rule for a model,
+// keyed by field name, as returned by Validate.
+type ValidationError = orm.ValidationError
+
+// Validator is the optional interface a model implements for validation
+// rules a `validate` struct tag can't express.
+type Validator = orm.Validator
+
+// ValidatorFunc implements a single named `validate` tag rule, registered
+// via RegisterValidator.
+type ValidatorFunc = orm.ValidatorFunc
+
 // Repository is the high-level, model-centric interface for CRUD operations.
 type Repository = model.Repository
 
@@ -21,12 +82,23 @@ var NewRepository = model.NewORMRepository
 var NewDataStore = model.NewORMDataStore
 
 var OpenDB = orm.OpenDB
+var Migrate = migrate.Migrate
+var MigrateTo = migrate.MigrateTo
+var MigrationStatus = migrate.MigrationStatus
 var CreateTable = orm.CreateTable
 var Query = orm.Query
 var QueryRow = orm.QueryRow
 var Exec = orm.Exec
+var InsertMany = orm.InsertMany
+var InsertMulti = orm.InsertMulti
+var UpdateMulti = orm.UpdateMulti
+var DeleteMulti = orm.DeleteMulti
+var BuildDSN = orm.BuildDSN
+var StartGC = orm.StartGC
 var BeginTx = orm.BeginTx
 var SanitizeInput = orm.SanitizeInput
+var Validate = orm.Validate
+var RegisterValidator = orm.RegisterValidator
 var GetTableName = orm.GetTableName
 var GetFieldInfo = orm.GetFieldInfo
 
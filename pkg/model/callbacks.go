@@ -0,0 +1,148 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/pballentine13/liteforge/internal/orm"
+)
+
+// Lifecycle hook interfaces. A model implements whichever of these it needs;
+// ORMRepository detects them via a type assertion and invokes them at the
+// matching point in the Save/Update/Delete flow. This mirrors gorm's
+// callback chain and is the standard extensibility point for timestamps,
+// soft deletes, validation, and audit logging.
+type (
+	BeforeCreateHook interface{ BeforeCreate(ds *orm.Datastore) error }
+	AfterCreateHook  interface{ AfterCreate(ds *orm.Datastore) error }
+	BeforeUpdateHook interface{ BeforeUpdate(ds *orm.Datastore) error }
+	AfterUpdateHook  interface{ AfterUpdate(ds *orm.Datastore) error }
+	BeforeSaveHook   interface{ BeforeSave(ds *orm.Datastore) error }
+	AfterSaveHook    interface{ AfterSave(ds *orm.Datastore) error }
+	BeforeDeleteHook interface{ BeforeDelete(ds *orm.Datastore) error }
+	AfterDeleteHook  interface{ AfterDelete(ds *orm.Datastore) error }
+	AfterFindHook    interface{ AfterFind(ds *orm.Datastore) error }
+)
+
+// CallbackFunc is a global callback registered via RegisterCallback. It
+// receives the datastore and the model instance currently being processed,
+// e.g. to auto-fill CreatedAt/UpdatedAt without editing every struct.
+type CallbackFunc func(ds *orm.Datastore, model any) error
+
+// RegisterCallback registers a global callback for the given lifecycle
+// event: "before_create", "after_create", "before_update", "after_update",
+// "before_save", "after_save", "before_delete", "after_delete", or
+// "after_find". Global callbacks for an event run in registration order:
+// for the before_* events they run before that event's per-model hook
+// interface, for the after_* events and after_find they run after it.
+func (r *ORMRepository) RegisterCallback(event string, fn CallbackFunc) {
+	if r.callbacks == nil {
+		r.callbacks = make(map[string][]CallbackFunc)
+	}
+	r.callbacks[event] = append(r.callbacks[event], fn)
+}
+
+// runCallbacks invokes every global callback registered for event, stopping
+// at the first error.
+func (r *ORMRepository) runCallbacks(event string, model any) error {
+	for _, fn := range r.callbacks[event] {
+		if err := fn(r.DS, model); err != nil {
+			return fmt.Errorf("%s callback failed: %w", event, err)
+		}
+	}
+	return nil
+}
+
+// runBeforeCreate runs the "before_create" global callbacks followed by the
+// model's BeforeCreateHook, if implemented.
+func (r *ORMRepository) runBeforeCreate(model any) error {
+	if err := r.runCallbacks("before_create", model); err != nil {
+		return err
+	}
+	if hook, ok := model.(BeforeCreateHook); ok {
+		if err := hook.BeforeCreate(r.DS); err != nil {
+			return fmt.Errorf("BeforeCreate hook failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ORMRepository) runAfterCreate(model any) error {
+	if hook, ok := model.(AfterCreateHook); ok {
+		if err := hook.AfterCreate(r.DS); err != nil {
+			return fmt.Errorf("AfterCreate hook failed: %w", err)
+		}
+	}
+	return r.runCallbacks("after_create", model)
+}
+
+func (r *ORMRepository) runBeforeUpdate(model any) error {
+	if err := r.runCallbacks("before_update", model); err != nil {
+		return err
+	}
+	if hook, ok := model.(BeforeUpdateHook); ok {
+		if err := hook.BeforeUpdate(r.DS); err != nil {
+			return fmt.Errorf("BeforeUpdate hook failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ORMRepository) runAfterUpdate(model any) error {
+	if hook, ok := model.(AfterUpdateHook); ok {
+		if err := hook.AfterUpdate(r.DS); err != nil {
+			return fmt.Errorf("AfterUpdate hook failed: %w", err)
+		}
+	}
+	return r.runCallbacks("after_update", model)
+}
+
+func (r *ORMRepository) runBeforeSave(model any) error {
+	if err := r.runCallbacks("before_save", model); err != nil {
+		return err
+	}
+	if hook, ok := model.(BeforeSaveHook); ok {
+		if err := hook.BeforeSave(r.DS); err != nil {
+			return fmt.Errorf("BeforeSave hook failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ORMRepository) runAfterSave(model any) error {
+	if hook, ok := model.(AfterSaveHook); ok {
+		if err := hook.AfterSave(r.DS); err != nil {
+			return fmt.Errorf("AfterSave hook failed: %w", err)
+		}
+	}
+	return r.runCallbacks("after_save", model)
+}
+
+func (r *ORMRepository) runBeforeDelete(model any) error {
+	if err := r.runCallbacks("before_delete", model); err != nil {
+		return err
+	}
+	if hook, ok := model.(BeforeDeleteHook); ok {
+		if err := hook.BeforeDelete(r.DS); err != nil {
+			return fmt.Errorf("BeforeDelete hook failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ORMRepository) runAfterDelete(model any) error {
+	if hook, ok := model.(AfterDeleteHook); ok {
+		if err := hook.AfterDelete(r.DS); err != nil {
+			return fmt.Errorf("AfterDelete hook failed: %w", err)
+		}
+	}
+	return r.runCallbacks("after_delete", model)
+}
+
+func (r *ORMRepository) runAfterFind(model any) error {
+	if hook, ok := model.(AfterFindHook); ok {
+		if err := hook.AfterFind(r.DS); err != nil {
+			return fmt.Errorf("AfterFind hook failed: %w", err)
+		}
+	}
+	return r.runCallbacks("after_find", model)
+}
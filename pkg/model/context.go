@@ -0,0 +1,229 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/pballentine13/liteforge/internal/orm"
+)
+
+// SaveContext is the context-aware equivalent of Save: it threads ctx
+// through to the underlying PrepareContext/ExecContext calls, and, for a
+// repository returned by WithTx, runs against that transaction instead of
+// the shared *sql.DB.
+func (r *ORMRepository) SaveContext(ctx context.Context, model any) (sql.Result, error) {
+	if r.DS == nil {
+		return nil, fmt.Errorf("datastore is nil")
+	}
+
+	if err := r.runBeforeSave(model); err != nil {
+		return nil, err
+	}
+
+	result, err := r.saveContext(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.runAfterSave(model); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// saveContext is the context-aware equivalent of save.
+func (r *ORMRepository) saveContext(ctx context.Context, model any) (sql.Result, error) {
+	pkValue, err := orm.GetPrimaryKeyValue(model)
+	if err != nil {
+		return r.insertContext(ctx, model)
+	}
+
+	v := reflect.ValueOf(pkValue)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v.IsZero() {
+			return r.insertContext(ctx, model)
+		}
+		return r.UpdateContext(ctx, model)
+	default:
+		return r.insertContext(ctx, model)
+	}
+}
+
+// insertContext is the context-aware equivalent of insert.
+func (r *ORMRepository) insertContext(ctx context.Context, model any) (sql.Result, error) {
+	if err := r.runBeforeCreate(model); err != nil {
+		return nil, err
+	}
+	result, err := orm.InsertContext(ctx, r.DS, r.querier(), model)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.runAfterCreate(model); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// FindByIDContext is the context-aware equivalent of FindByID.
+func (r *ORMRepository) FindByIDContext(ctx context.Context, model any, id int) error {
+	if r.DS == nil {
+		return fmt.Errorf("datastore is nil")
+	}
+
+	v := reflect.ValueOf(model)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("model must be a non-nil pointer to a struct")
+	}
+	v = v.Elem()
+
+	tableName := orm.GetTableName(model)
+	columns, _ := orm.GetFieldInfo(model)
+	if len(columns) == 0 {
+		return fmt.Errorf("model has no fields to query")
+	}
+
+	pkCol, err := orm.GetPrimaryKeyColumn(model)
+	if err != nil {
+		return fmt.Errorf("model must have a primary key field with 'pk' tag: %w", err)
+	}
+
+	adapter := r.DS.Adapter
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s = %s",
+		quoteIdentList(adapter, columns),
+		adapter.QuoteIdent(tableName),
+		adapter.QuoteIdent(pkCol),
+		adapter.GetPlaceholder(1),
+	)
+
+	row, err := orm.QueryRowContext(ctx, r.DS, r.querier(), query, id)
+	if err != nil {
+		return fmt.Errorf("failed to query row: %w", err)
+	}
+
+	dest, err := scanDestinations(v, columns)
+	if err != nil {
+		return err
+	}
+
+	if err := row.Scan(dest...); err != nil {
+		if err == sql.ErrNoRows {
+			return err
+		}
+		return fmt.Errorf("failed to scan row into model: %w", err)
+	}
+
+	return r.runAfterFind(model)
+}
+
+// UpdateContext is the context-aware equivalent of Update.
+func (r *ORMRepository) UpdateContext(ctx context.Context, model any) (sql.Result, error) {
+	if r.DS == nil {
+		return nil, fmt.Errorf("datastore is nil")
+	}
+
+	if err := r.runBeforeUpdate(model); err != nil {
+		return nil, err
+	}
+
+	result, err := r.updateContext(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.runAfterUpdate(model); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// updateContext is the context-aware equivalent of update.
+func (r *ORMRepository) updateContext(ctx context.Context, model any) (sql.Result, error) {
+	tableName := orm.GetTableName(model)
+	columns, values := orm.GetFieldInfo(model)
+
+	pkCol, err := orm.GetPrimaryKeyColumn(model)
+	if err != nil {
+		return nil, fmt.Errorf("model must have a primary key field with 'pk' tag: %w", err)
+	}
+	pkValue, err := orm.GetPrimaryKeyValue(model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get primary key value: %w", err)
+	}
+
+	setClauses := make([]string, 0, len(columns))
+	updateValues := make([]any, 0, len(values))
+	placeholderIndex := 1
+
+	adapter := r.DS.Adapter
+	for i, col := range columns {
+		if col == pkCol {
+			continue // Skip primary key in SET clause
+		}
+		setClauses = append(setClauses, fmt.Sprintf("%s = %s", adapter.QuoteIdent(col), adapter.GetPlaceholder(placeholderIndex)))
+		updateValues = append(updateValues, values[i])
+		placeholderIndex++
+	}
+
+	if len(setClauses) == 0 {
+		return nil, fmt.Errorf("no fields to update")
+	}
+
+	updateValues = append(updateValues, pkValue)
+
+	query := fmt.Sprintf("UPDATE %s SET %s WHERE %s = %s",
+		adapter.QuoteIdent(tableName),
+		strings.Join(setClauses, ", "),
+		adapter.QuoteIdent(pkCol),
+		adapter.GetPlaceholder(placeholderIndex),
+	)
+
+	return orm.ExecContext(ctx, r.DS, r.querier(), query, updateValues...)
+}
+
+// DeleteContext is the context-aware equivalent of Delete.
+func (r *ORMRepository) DeleteContext(ctx context.Context, model any) (sql.Result, error) {
+	if r.DS == nil {
+		return nil, fmt.Errorf("datastore is nil")
+	}
+
+	if err := r.runBeforeDelete(model); err != nil {
+		return nil, err
+	}
+
+	result, err := r.deleteContext(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.runAfterDelete(model); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// deleteContext is the context-aware equivalent of delete.
+func (r *ORMRepository) deleteContext(ctx context.Context, model any) (sql.Result, error) {
+	tableName := orm.GetTableName(model)
+
+	pkCol, err := orm.GetPrimaryKeyColumn(model)
+	if err != nil {
+		return nil, fmt.Errorf("model must have a primary key field with 'pk' tag: %w", err)
+	}
+	pkValue, err := orm.GetPrimaryKeyValue(model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get primary key value: %w", err)
+	}
+
+	query := fmt.Sprintf("DELETE FROM %s WHERE %s = %s",
+		r.DS.Adapter.QuoteIdent(tableName),
+		r.DS.Adapter.QuoteIdent(pkCol),
+		r.DS.Adapter.GetPlaceholder(1),
+	)
+
+	return orm.ExecContext(ctx, r.DS, r.querier(), query, pkValue)
+}
@@ -4,6 +4,8 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+
+	"github.com/pballentine13/liteforge/internal/orm"
 )
 
 // DataStore defines the application-specific interface for data access.
@@ -40,12 +42,18 @@ func (ds *ORMDataStore) GetUserByID(id int) (*User, error) {
 	return user, nil
 }
 
-// SaveUser saves a User (insert or update) using the ORMRepository.
+// SaveUser saves a User (insert or update) using the ORMRepository, first
+// validating it against its `validate` tags (and Validate() method, if
+// implemented).
 func (ds *ORMDataStore) SaveUser(user *User) error {
 	if ds.Repo == nil {
 		return errors.New("repository is nil")
 	}
 
+	if err := orm.Validate(user); err != nil {
+		return err
+	}
+
 	_, err := ds.Repo.Save(user)
 	if err != nil {
 		return fmt.Errorf("failed to save user: %w", err)
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/pballentine13/liteforge/internal/orm"
+)
+
+// quoteIdentList quotes each identifier in names via adapter.QuoteIdent and
+// joins them for a column list, so call sites building SELECT/INSERT/UPDATE
+// SQL from orm.GetFieldInfo's column names don't have to repeat the
+// join-after-quoting boilerplate.
+func quoteIdentList(adapter orm.DBAdapter, names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = adapter.QuoteIdent(name)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -25,6 +25,18 @@ type Repository interface {
 // that holds a reference to *orm.Datastore.
 type ORMRepository struct {
 	DS *orm.Datastore
+
+	// callbacks holds global lifecycle callbacks registered via
+	// RegisterCallback, keyed by event name.
+	callbacks map[string][]CallbackFunc
+
+	// conn is the connection context-aware methods (the *Context variants
+	// and everything called through them) run their queries against. It is
+	// nil for a repository returned by NewORMRepository, in which case
+	// querier() falls back to DS.DB; WithTx sets it to the open *sql.Tx so
+	// that the context-aware calls made through the repository it hands to
+	// its closure participate in the same transaction.
+	conn orm.Querier
 }
 
 // NewORMRepository creates a new ORMRepository instance.
@@ -33,28 +45,70 @@ func NewORMRepository(ds *orm.Datastore) *ORMRepository {
 }
 
 // Save handles both INSERT and UPDATE.
-// It checks the primary key value to determine the operation.
+// It checks the primary key value to determine the operation, running the
+// BeforeSave/AfterSave callbacks around it and BeforeCreate/AfterCreate (or
+// Update's own BeforeUpdate/AfterUpdate) around the underlying operation. A
+// non-nil callback error aborts the save.
 func (r *ORMRepository) Save(model any) (sql.Result, error) {
 	if r.DS == nil {
 		return nil, fmt.Errorf("datastore is nil")
 	}
 
+	if err := r.runBeforeSave(model); err != nil {
+		return nil, err
+	}
+
+	result, err := r.save(model)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.runAfterSave(model); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// save performs the actual INSERT-or-UPDATE decision, without the
+// BeforeSave/AfterSave wrapping (which Save itself provides).
+func (r *ORMRepository) save(model any) (sql.Result, error) {
 	pkValue, err := orm.GetPrimaryKeyValue(model)
 	if err != nil {
 		// If no PK is found, default to Insert.
-		return orm.Insert(r.DS, model)
+		return r.insert(model)
 	}
 
-	// Check if the PK value is zero/default (e.g., 0 for int).
+	// An unset (zero-value) PK means the row hasn't been inserted yet. Only
+	// integer PKs are auto-generated by the database, though, so a non-zero
+	// value only means "already persisted" for those; a string (or other
+	// non-integer) PK is always caller-assigned, with no zero-value
+	// convention to tell a new row from an existing one, so always insert.
 	v := reflect.ValueOf(pkValue)
-	if v.Kind() == reflect.Int || v.Kind() == reflect.Int64 {
-		if v.Int() == 0 {
-			return orm.Insert(r.DS, model)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v.IsZero() {
+			return r.insert(model)
 		}
+		return r.Update(model)
+	default:
+		return r.insert(model)
 	}
+}
 
-	// If PK is set (non-zero int), perform an update.
-	return r.Update(model)
+// insert runs the BeforeCreate/AfterCreate callbacks around orm.Insert.
+func (r *ORMRepository) insert(model any) (sql.Result, error) {
+	if err := r.runBeforeCreate(model); err != nil {
+		return nil, err
+	}
+	result, err := orm.Insert(r.DS, model)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.runAfterCreate(model); err != nil {
+		return result, err
+	}
+	return result, nil
 }
 
 // FindByID populates the provided model struct with data for the given ID.
@@ -69,7 +123,6 @@ func (r *ORMRepository) FindByID(model any, id int) error {
 		return fmt.Errorf("model must be a non-nil pointer to a struct")
 	}
 	v = v.Elem()
-	t := v.Type()
 
 	// 1. Get table name and column names
 	tableName := orm.GetTableName(model)
@@ -86,11 +139,12 @@ func (r *ORMRepository) FindByID(model any, id int) error {
 	}
 
 	// 3. Build the query
+	adapter := r.DS.Adapter
 	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s = %s",
-		strings.Join(columns, ", "),
-		tableName,
-		pkCol,
-		r.DS.Adapter.GetPlaceholder(1),
+		quoteIdentList(adapter, columns),
+		adapter.QuoteIdent(tableName),
+		adapter.QuoteIdent(pkCol),
+		adapter.GetPlaceholder(1),
 	)
 
 	// 4. Execute the query
@@ -99,22 +153,13 @@ func (r *ORMRepository) FindByID(model any, id int) error {
 		return fmt.Errorf("failed to query row: %w", err)
 	}
 
-	// 5. Prepare destination pointers for Scan
-	dest := make([]any, len(columns))
-	for i, col := range columns {
-		field := t.Field(i)
-		fieldValue := v.Field(i)
-
-		// Safety check: ensure the column name matches the lowercase field name
-		if strings.ToLower(field.Name) != col {
-			// This should not happen if GetFieldInfo is correct, but is a good safeguard.
-			return fmt.Errorf("internal error: column name mismatch for index %d: expected %s, got %s", i, strings.ToLower(field.Name), col)
-		}
-
-		if !fieldValue.CanSet() {
-			return fmt.Errorf("field %s is not settable", field.Name)
-		}
-		dest[i] = fieldValue.Addr().Interface()
+	// 5. Prepare destination pointers for Scan. Relation fields don't map
+	// 1:1 to struct field index once has-many/many-to-many fields are
+	// excluded and belongs-to fields are redirected to their FK column, so
+	// destinations are matched by column name rather than position.
+	dest, err := scanDestinations(v, columns)
+	if err != nil {
+		return err
 	}
 
 	// 6. Scan the row
@@ -125,15 +170,35 @@ func (r *ORMRepository) FindByID(model any, id int) error {
 		return fmt.Errorf("failed to scan row into model: %w", err)
 	}
 
-	return nil
+	// 7. Run the AfterFind hook/callbacks now that the model is populated.
+	return r.runAfterFind(model)
 }
 
-// Update explicitly updates an existing record.
+// Update explicitly updates an existing record, running the
+// BeforeUpdate/AfterUpdate callbacks around the UPDATE statement.
 func (r *ORMRepository) Update(model any) (sql.Result, error) {
 	if r.DS == nil {
 		return nil, fmt.Errorf("datastore is nil")
 	}
 
+	if err := r.runBeforeUpdate(model); err != nil {
+		return nil, err
+	}
+
+	result, err := r.update(model)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.runAfterUpdate(model); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// update performs the actual UPDATE statement, without the
+// BeforeUpdate/AfterUpdate wrapping (which Update itself provides).
+func (r *ORMRepository) update(model any) (sql.Result, error) {
 	// 1. Get table name, columns, and values
 	tableName := orm.GetTableName(model)
 	columns, values := orm.GetFieldInfo(model)
@@ -153,11 +218,12 @@ func (r *ORMRepository) Update(model any) (sql.Result, error) {
 	updateValues := make([]any, 0, len(values))
 	placeholderIndex := 1
 
+	adapter := r.DS.Adapter
 	for i, col := range columns {
 		if col == pkCol {
 			continue // Skip primary key in SET clause
 		}
-		setClauses = append(setClauses, fmt.Sprintf("%s = %s", col, r.DS.Adapter.GetPlaceholder(placeholderIndex)))
+		setClauses = append(setClauses, fmt.Sprintf("%s = %s", adapter.QuoteIdent(col), adapter.GetPlaceholder(placeholderIndex)))
 		updateValues = append(updateValues, values[i])
 		placeholderIndex++
 	}
@@ -171,22 +237,41 @@ func (r *ORMRepository) Update(model any) (sql.Result, error) {
 
 	// 5. Build the query
 	query := fmt.Sprintf("UPDATE %s SET %s WHERE %s = %s",
-		tableName,
+		adapter.QuoteIdent(tableName),
 		strings.Join(setClauses, ", "),
-		pkCol,
-		r.DS.Adapter.GetPlaceholder(placeholderIndex), // The last placeholder for the PK value
+		adapter.QuoteIdent(pkCol),
+		adapter.GetPlaceholder(placeholderIndex), // The last placeholder for the PK value
 	)
 
 	// 6. Execute the query
 	return orm.Exec(r.DS, query, updateValues...)
 }
 
-// Delete deletes a record based on the model's primary key.
+// Delete deletes a record based on the model's primary key, running the
+// BeforeDelete/AfterDelete callbacks around the DELETE statement.
 func (r *ORMRepository) Delete(model any) (sql.Result, error) {
 	if r.DS == nil {
 		return nil, fmt.Errorf("datastore is nil")
 	}
 
+	if err := r.runBeforeDelete(model); err != nil {
+		return nil, err
+	}
+
+	result, err := r.delete(model)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.runAfterDelete(model); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// delete performs the actual DELETE statement, without the
+// BeforeDelete/AfterDelete wrapping (which Delete itself provides).
+func (r *ORMRepository) delete(model any) (sql.Result, error) {
 	// 1. Get table name
 	tableName := orm.GetTableName(model)
 
@@ -202,8 +287,8 @@ func (r *ORMRepository) Delete(model any) (sql.Result, error) {
 
 	// 3. Build the query
 	query := fmt.Sprintf("DELETE FROM %s WHERE %s = %s",
-		tableName,
-		pkCol,
+		r.DS.Adapter.QuoteIdent(tableName),
+		r.DS.Adapter.QuoteIdent(pkCol),
 		r.DS.Adapter.GetPlaceholder(1),
 	)
 
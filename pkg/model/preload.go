@@ -0,0 +1,290 @@
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/pballentine13/liteforge/internal/orm"
+)
+
+// PreloadSet wraps an ORMRepository with a list of relation paths to
+// eager-load after FindByID populates the root model, avoiding N+1 queries
+// for belongs-to, has-many, and many-to-many relations declared via
+// `liteforge:"..."` struct tags. Paths may be dot-separated to reach nested
+// relations, e.g. Preload("Comments", "Tags.Author").
+type PreloadSet struct {
+	repo  *ORMRepository
+	paths []string
+}
+
+// Preload returns a PreloadSet that eager-loads the given relation paths
+// once FindByID has populated the root model.
+func (r *ORMRepository) Preload(paths ...string) *PreloadSet {
+	return &PreloadSet{repo: r, paths: paths}
+}
+
+// FindByID populates model via the underlying repository, then eager-loads
+// every registered relation path.
+func (ps *PreloadSet) FindByID(model any, id int) error {
+	if err := ps.repo.FindByID(model, id); err != nil {
+		return err
+	}
+	for _, path := range ps.paths {
+		if err := ps.repo.loadRelationPath(model, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadRelationPath loads the first segment of a dot-separated relation path
+// onto parent, then recurses into whatever got loaded for the remaining segments.
+func (r *ORMRepository) loadRelationPath(parent any, path string) error {
+	segments := strings.SplitN(path, ".", 2)
+	fieldName := segments[0]
+
+	relations := orm.GetRelations(parent)
+	rel, ok := relations[fieldName]
+	if !ok {
+		return fmt.Errorf("liteforge: %T has no relation %q", parent, fieldName)
+	}
+
+	switch rel.Kind {
+	case orm.RelationBelongsTo:
+		if err := r.loadBelongsTo(parent, rel); err != nil {
+			return err
+		}
+	case orm.RelationHasMany:
+		if err := r.loadHasMany(parent, rel); err != nil {
+			return err
+		}
+	case orm.RelationManyToMany:
+		if err := r.loadManyToMany(parent, rel); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("liteforge: %q is not a relation field", fieldName)
+	}
+
+	if len(segments) < 2 {
+		return nil
+	}
+
+	// Recurse into whatever was just loaded, for nested paths like "Tags.Author".
+	v := reflect.ValueOf(parent)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	field := v.FieldByName(fieldName)
+	return r.loadNestedPath(field, segments[1])
+}
+
+// loadNestedPath applies a relation path to every element reachable through
+// field, whether it is a single belongs-to pointer or a has-many/many-to-many slice.
+func (r *ORMRepository) loadNestedPath(field reflect.Value, path string) error {
+	switch field.Kind() {
+	case reflect.Ptr:
+		if field.IsNil() {
+			return nil
+		}
+		return r.loadRelationPath(field.Interface(), path)
+	case reflect.Slice:
+		for i := 0; i < field.Len(); i++ {
+			elem := field.Index(i)
+			var target any
+			if elem.Kind() == reflect.Ptr {
+				target = elem.Interface()
+			} else {
+				target = elem.Addr().Interface()
+			}
+			if err := r.loadRelationPath(target, path); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("liteforge: cannot preload nested path %q on a %s field", path, field.Kind())
+	}
+}
+
+// relatedStructType resolves the element struct type a relation field's
+// slice or pointer holds, e.g. []Comment or []*Comment -> Comment.
+func relatedStructType(field reflect.Value) (structType reflect.Type, isPtr bool) {
+	t := field.Type()
+	if field.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Ptr {
+		return t.Elem(), true
+	}
+	return t, false
+}
+
+// loadBelongsTo populates a belongs-to relation field by looking up the FK
+// column's value on parent's row, then fetching the referenced row by its
+// primary key.
+func (r *ORMRepository) loadBelongsTo(parent any, rel orm.RelationInfo) error {
+	v := reflect.ValueOf(parent)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	field := v.FieldByName(rel.FieldName)
+	structType, _ := relatedStructType(field)
+
+	parentTable := orm.GetTableName(parent)
+	pkCol, err := orm.GetPrimaryKeyColumn(parent)
+	if err != nil {
+		return fmt.Errorf("failed to preload %s: %w", rel.FieldName, err)
+	}
+	pkValue, err := orm.GetPrimaryKeyValue(parent)
+	if err != nil {
+		return fmt.Errorf("failed to preload %s: %w", rel.FieldName, err)
+	}
+
+	adapter := r.DS.Adapter
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s = %s",
+		adapter.QuoteIdent(rel.FKColumn), adapter.QuoteIdent(parentTable), adapter.QuoteIdent(pkCol), adapter.GetPlaceholder(1))
+	row, err := orm.QueryRow(r.DS, query, pkValue)
+	if err != nil {
+		return fmt.Errorf("failed to preload %s: %w", rel.FieldName, err)
+	}
+
+	var fkValue *int64
+	if err := row.Scan(&fkValue); err != nil {
+		return fmt.Errorf("failed to scan FK column %s: %w", rel.FKColumn, err)
+	}
+	if fkValue == nil {
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+
+	related := reflect.New(structType)
+	if err := r.FindByID(related.Interface(), int(*fkValue)); err != nil {
+		return fmt.Errorf("failed to preload %s: %w", rel.FieldName, err)
+	}
+	field.Set(related)
+	return nil
+}
+
+// loadHasMany populates a has-many relation field with every row in the
+// related table whose FK column matches parent's primary key.
+func (r *ORMRepository) loadHasMany(parent any, rel orm.RelationInfo) error {
+	v := reflect.ValueOf(parent)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	field := v.FieldByName(rel.FieldName)
+	structType, isPtr := relatedStructType(field)
+
+	childZero := reflect.New(structType).Elem().Interface()
+	childTable := orm.GetTableName(childZero)
+	childColumns, _ := orm.GetFieldInfo(childZero)
+
+	pkValue, err := orm.GetPrimaryKeyValue(parent)
+	if err != nil {
+		return fmt.Errorf("failed to preload %s: %w", rel.FieldName, err)
+	}
+
+	adapter := r.DS.Adapter
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s = %s",
+		quoteIdentList(adapter, childColumns), adapter.QuoteIdent(childTable), adapter.QuoteIdent(rel.FKColumn), adapter.GetPlaceholder(1))
+
+	slice, err := r.scanRelatedRows(query, []any{pkValue}, structType, isPtr, childColumns)
+	if err != nil {
+		return fmt.Errorf("failed to preload %s: %w", rel.FieldName, err)
+	}
+	field.Set(slice)
+	return nil
+}
+
+// loadManyToMany populates a many-to-many relation field by joining through
+// the relation's join table. The join table is expected to have one column
+// per side, named after the parent and related tables respectively
+// (e.g. post_tags(post_id, tag_id) links post and tag).
+func (r *ORMRepository) loadManyToMany(parent any, rel orm.RelationInfo) error {
+	v := reflect.ValueOf(parent)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	field := v.FieldByName(rel.FieldName)
+	structType, isPtr := relatedStructType(field)
+
+	parentTable := orm.GetTableName(parent)
+	childZero := reflect.New(structType).Elem().Interface()
+	childTable := orm.GetTableName(childZero)
+	childColumns, _ := orm.GetFieldInfo(childZero)
+	childPKCol, err := orm.GetPrimaryKeyColumn(childZero)
+	if err != nil {
+		return fmt.Errorf("failed to preload %s: %w", rel.FieldName, err)
+	}
+
+	parentPKCol := parentTable + "_id"
+	childJoinCol := childTable + "_id"
+
+	pkValue, err := orm.GetPrimaryKeyValue(parent)
+	if err != nil {
+		return fmt.Errorf("failed to preload %s: %w", rel.FieldName, err)
+	}
+
+	adapter := r.DS.Adapter
+	quotedChildTable := adapter.QuoteIdent(childTable)
+	quotedJoinTable := adapter.QuoteIdent(rel.JoinTable)
+
+	prefixedColumns := make([]string, len(childColumns))
+	for i, col := range childColumns {
+		prefixedColumns[i] = quotedChildTable + "." + adapter.QuoteIdent(col)
+	}
+
+	query := fmt.Sprintf(
+		"SELECT %s FROM %s JOIN %s ON %s.%s = %s.%s WHERE %s.%s = %s",
+		strings.Join(prefixedColumns, ", "),
+		quotedChildTable,
+		quotedJoinTable,
+		quotedChildTable, adapter.QuoteIdent(childPKCol),
+		quotedJoinTable, adapter.QuoteIdent(childJoinCol),
+		quotedJoinTable, adapter.QuoteIdent(parentPKCol),
+		adapter.GetPlaceholder(1),
+	)
+
+	slice, err := r.scanRelatedRows(query, []any{pkValue}, structType, isPtr, childColumns)
+	if err != nil {
+		return fmt.Errorf("failed to preload %s: %w", rel.FieldName, err)
+	}
+	field.Set(slice)
+	return nil
+}
+
+// scanRelatedRows runs query and scans every row into a new slice of
+// structType (or *structType, when isPtr), returning the slice as a
+// reflect.Value ready to be assigned to a relation field.
+func (r *ORMRepository) scanRelatedRows(query string, args []any, structType reflect.Type, isPtr bool, columns []string) (reflect.Value, error) {
+	elemType := structType
+	if isPtr {
+		elemType = reflect.PointerTo(structType)
+	}
+	slice := reflect.MakeSlice(reflect.SliceOf(elemType), 0, 0)
+
+	rows, err := orm.Query(r.DS, query, args...)
+	if err != nil {
+		return slice, fmt.Errorf("failed to query related rows: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		elemPtr := reflect.New(structType)
+		dest, err := scanDestinations(elemPtr.Elem(), columns)
+		if err != nil {
+			return slice, err
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return slice, fmt.Errorf("failed to scan related row: %w", err)
+		}
+		if isPtr {
+			slice = reflect.Append(slice, elemPtr)
+		} else {
+			slice = reflect.Append(slice, elemPtr.Elem())
+		}
+	}
+	return slice, rows.Err()
+}
@@ -0,0 +1,375 @@
+package model
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pballentine13/liteforge/internal/orm"
+)
+
+// supportedLookupOps enumerates the double-underscore lookup suffixes a
+// QuerySet understands, mirroring Django/Beego's field lookup API.
+var supportedLookupOps = map[string]bool{
+	"exact": true, "iexact": true, "contains": true, "icontains": true,
+	"startswith": true, "endswith": true, "istartswith": true, "iendswith": true,
+	"gt": true, "gte": true, "lt": true, "lte": true, "in": true, "isnull": true,
+}
+
+// queryCondition is a single parsed Filter call.
+type queryCondition struct {
+	column string
+	op     string
+	value  any
+}
+
+// QuerySet is a chainable, Django/Beego-style query builder layered on top of
+// an ORMRepository. It is constructed via ORMRepository.Query and lazily
+// builds parameterized SQL as conditions and modifiers are chained on.
+type QuerySet struct {
+	repo       *ORMRepository
+	tableName  string
+	columns    []string
+	conditions []queryCondition
+	order      []string
+	limit      int
+	offset     int
+	err        error
+}
+
+// Query returns a new QuerySet scoped to model's table, e.g.
+// repo.Query(&User{}).Filter("age__gt", 30).OrderBy("-age").All(&users).
+func (r *ORMRepository) Query(model any) *QuerySet {
+	qs := &QuerySet{repo: r}
+	if r.DS == nil {
+		qs.err = fmt.Errorf("datastore is nil")
+		return qs
+	}
+	qs.tableName = orm.GetTableName(model)
+	qs.columns, _ = orm.GetFieldInfo(model)
+	return qs
+}
+
+// parseLookup splits a "field__op" lookup into its column and operator,
+// defaulting to "exact" when no recognized double-underscore suffix is present.
+func parseLookup(lookup string) (column, op string) {
+	if idx := strings.LastIndex(lookup, "__"); idx != -1 {
+		candidate := lookup[idx+2:]
+		if supportedLookupOps[candidate] {
+			return strings.ToLower(lookup[:idx]), candidate
+		}
+	}
+	return strings.ToLower(lookup), "exact"
+}
+
+func (qs *QuerySet) hasColumn(column string) bool {
+	for _, c := range qs.columns {
+		if c == column {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter adds a WHERE condition. lookup is a column name, optionally suffixed
+// with "__<op>" (e.g. "age__gt", "name__icontains", "status__in").
+func (qs *QuerySet) Filter(lookup string, value any) *QuerySet {
+	if qs.err != nil {
+		return qs
+	}
+	column, op := parseLookup(lookup)
+	if !qs.hasColumn(column) {
+		qs.err = fmt.Errorf("liteforge: unknown field %q for table %s", column, qs.tableName)
+		return qs
+	}
+	qs.conditions = append(qs.conditions, queryCondition{column: column, op: op, value: value})
+	return qs
+}
+
+// OrderBy sets the ORDER BY clause. Prefix a field with "-" for descending order.
+func (qs *QuerySet) OrderBy(fields ...string) *QuerySet {
+	if qs.err != nil {
+		return qs
+	}
+	for _, f := range fields {
+		dir := "ASC"
+		column := f
+		if strings.HasPrefix(f, "-") {
+			dir = "DESC"
+			column = f[1:]
+		}
+		column = strings.ToLower(column)
+		if !qs.hasColumn(column) {
+			qs.err = fmt.Errorf("liteforge: unknown field %q for table %s", column, qs.tableName)
+			return qs
+		}
+		qs.order = append(qs.order, column+" "+dir)
+	}
+	return qs
+}
+
+// Limit caps the number of rows returned.
+func (qs *QuerySet) Limit(n int) *QuerySet {
+	qs.limit = n
+	return qs
+}
+
+// Offset skips the first n matching rows.
+func (qs *QuerySet) Offset(n int) *QuerySet {
+	qs.offset = n
+	return qs
+}
+
+// escapeLike escapes LIKE metacharacters ('%', '_') in a caller-supplied
+// value so they're matched literally; the caller then appends the wildcards
+// appropriate for contains/startswith/endswith.
+func escapeLike(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+	return replacer.Replace(value)
+}
+
+// buildCondition renders a single condition to a SQL fragment (using "?" as
+// a generic placeholder marker) plus its bind arguments.
+func (qs *QuerySet) buildCondition(c queryCondition) (string, []any, error) {
+	adapter := qs.repo.DS.Adapter
+	switch c.op {
+	case "in":
+		values := reflect.ValueOf(c.value)
+		if values.Kind() != reflect.Slice {
+			return "", nil, fmt.Errorf("liteforge: %s__in requires a slice value", c.column)
+		}
+		n := values.Len()
+		placeholders := make([]string, n)
+		args := make([]any, n)
+		for i := 0; i < n; i++ {
+			placeholders[i] = "?"
+			args[i] = values.Index(i).Interface()
+		}
+		return fmt.Sprintf("%s IN (%s)", c.column, strings.Join(placeholders, ", ")), args, nil
+	case "isnull":
+		if want, _ := c.value.(bool); want {
+			return c.column + " IS NULL", nil, nil
+		}
+		return c.column + " IS NOT NULL", nil, nil
+	case "contains", "icontains":
+		return adapter.OperatorSQL(c.op, c.column) + ` ESCAPE '\'`, []any{"%" + escapeLike(fmt.Sprintf("%v", c.value)) + "%"}, nil
+	case "startswith", "istartswith":
+		return adapter.OperatorSQL(c.op, c.column) + ` ESCAPE '\'`, []any{escapeLike(fmt.Sprintf("%v", c.value)) + "%"}, nil
+	case "endswith", "iendswith":
+		return adapter.OperatorSQL(c.op, c.column) + ` ESCAPE '\'`, []any{"%" + escapeLike(fmt.Sprintf("%v", c.value))}, nil
+	default:
+		return adapter.OperatorSQL(c.op, c.column), []any{c.value}, nil
+	}
+}
+
+// rebindPlaceholders replaces sequential "?" markers in sqlFragment with the
+// adapter's real placeholder syntax (e.g. "$1", "$2" for Postgres), starting
+// the count at startIndex.
+func (qs *QuerySet) rebindPlaceholders(sqlFragment string, startIndex int) string {
+	adapter := qs.repo.DS.Adapter
+	var b strings.Builder
+	idx := startIndex
+	for _, r := range sqlFragment {
+		if r == '?' {
+			b.WriteString(adapter.GetPlaceholder(idx))
+			idx++
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// buildWhere renders every condition, ANDed together, rebinding generic "?"
+// placeholders to the adapter's real syntax starting at startIndex.
+func (qs *QuerySet) buildWhere(startIndex int) (string, []any, error) {
+	if len(qs.conditions) == 0 {
+		return "", nil, nil
+	}
+	var clauses []string
+	var args []any
+	for _, c := range qs.conditions {
+		clause, clauseArgs, err := qs.buildCondition(c)
+		if err != nil {
+			return "", nil, err
+		}
+		clauses = append(clauses, clause)
+		args = append(args, clauseArgs...)
+	}
+	where := qs.rebindPlaceholders(strings.Join(clauses, " AND "), startIndex)
+	return "WHERE " + where, args, nil
+}
+
+// All executes the query and scans every matching row into dest, which must
+// be a pointer to a slice of structs (or pointers to structs).
+func (qs *QuerySet) All(dest any) error {
+	if qs.err != nil {
+		return qs.err
+	}
+	sliceVal := reflect.ValueOf(dest)
+	if sliceVal.Kind() != reflect.Ptr || sliceVal.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("liteforge: All requires a pointer to a slice")
+	}
+	sliceElem := sliceVal.Elem()
+	elemType := sliceElem.Type().Elem()
+	isPtr := elemType.Kind() == reflect.Ptr
+	structType := elemType
+	if isPtr {
+		structType = structType.Elem()
+	}
+
+	where, args, err := qs.buildWhere(1)
+	if err != nil {
+		return err
+	}
+
+	adapter := qs.repo.DS.Adapter
+	query := fmt.Sprintf("SELECT %s FROM %s", quoteIdentList(adapter, qs.columns), adapter.QuoteIdent(qs.tableName))
+	if where != "" {
+		query += " " + where
+	}
+	if len(qs.order) > 0 {
+		query += " ORDER BY " + strings.Join(qs.order, ", ")
+	}
+	if qs.limit > 0 {
+		query += " LIMIT " + strconv.Itoa(qs.limit)
+	}
+	if qs.offset > 0 {
+		query += " OFFSET " + strconv.Itoa(qs.offset)
+	}
+
+	rows, err := orm.Query(qs.repo.DS, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to query rows: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		elemPtr := reflect.New(structType)
+		scanDest, err := scanDestinations(elemPtr.Elem(), qs.columns)
+		if err != nil {
+			return err
+		}
+		if err := rows.Scan(scanDest...); err != nil {
+			return fmt.Errorf("failed to scan row: %w", err)
+		}
+		if isPtr {
+			sliceElem.Set(reflect.Append(sliceElem, elemPtr))
+		} else {
+			sliceElem.Set(reflect.Append(sliceElem, elemPtr.Elem()))
+		}
+	}
+	return rows.Err()
+}
+
+// One executes the query and scans the first matching row into dest, which
+// must be a pointer to a struct. It returns sql.ErrNoRows if nothing matches.
+func (qs *QuerySet) One(dest any) error {
+	if qs.err != nil {
+		return qs.err
+	}
+	qs.limit = 1
+	sliceType := reflect.SliceOf(reflect.TypeOf(dest).Elem())
+	slicePtr := reflect.New(sliceType)
+	if err := qs.All(slicePtr.Interface()); err != nil {
+		return err
+	}
+	slice := slicePtr.Elem()
+	if slice.Len() == 0 {
+		return sql.ErrNoRows
+	}
+	reflect.ValueOf(dest).Elem().Set(slice.Index(0))
+	return nil
+}
+
+// Count returns the number of rows matching the QuerySet's conditions.
+func (qs *QuerySet) Count() (int64, error) {
+	if qs.err != nil {
+		return 0, qs.err
+	}
+	where, args, err := qs.buildWhere(1)
+	if err != nil {
+		return 0, err
+	}
+	query := "SELECT COUNT(*) FROM " + qs.repo.DS.Adapter.QuoteIdent(qs.tableName)
+	if where != "" {
+		query += " " + where
+	}
+	row, err := orm.QueryRow(qs.repo.DS, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query count: %w", err)
+	}
+	var count int64
+	if err := row.Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to scan count: %w", err)
+	}
+	return count, nil
+}
+
+// Exists reports whether any row matches the QuerySet's conditions.
+func (qs *QuerySet) Exists() (bool, error) {
+	count, err := qs.Count()
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// Delete removes every row matching the QuerySet's conditions.
+func (qs *QuerySet) Delete() (sql.Result, error) {
+	if qs.err != nil {
+		return nil, qs.err
+	}
+	where, args, err := qs.buildWhere(1)
+	if err != nil {
+		return nil, err
+	}
+	query := "DELETE FROM " + qs.repo.DS.Adapter.QuoteIdent(qs.tableName)
+	if where != "" {
+		query += " " + where
+	}
+	return orm.Exec(qs.repo.DS, query, args...)
+}
+
+// Update sets the given columns on every row matching the QuerySet's conditions.
+func (qs *QuerySet) Update(values map[string]any) (sql.Result, error) {
+	if qs.err != nil {
+		return nil, qs.err
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("liteforge: Update requires at least one column")
+	}
+
+	columns := make([]string, 0, len(values))
+	for col := range values {
+		columns = append(columns, col)
+	}
+	sort.Strings(columns) // deterministic SET clause / placeholder ordering
+
+	adapter := qs.repo.DS.Adapter
+	setClauses := make([]string, len(columns))
+	args := make([]any, len(columns))
+	for i, col := range columns {
+		if !qs.hasColumn(col) {
+			return nil, fmt.Errorf("liteforge: unknown field %q for table %s", col, qs.tableName)
+		}
+		setClauses[i] = adapter.QuoteIdent(col) + " = ?"
+		args[i] = values[col]
+	}
+
+	where, whereArgs, err := qs.buildWhere(len(columns) + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	setClause := qs.rebindPlaceholders(strings.Join(setClauses, ", "), 1)
+	query := fmt.Sprintf("UPDATE %s SET %s", adapter.QuoteIdent(qs.tableName), setClause)
+	if where != "" {
+		query += " " + where
+	}
+
+	return orm.Exec(qs.repo.DS, query, append(args, whereArgs...)...)
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/pballentine13/liteforge/internal/orm"
+)
+
+// scanDestinations builds a []any of scan targets for elem (a struct value,
+// not a pointer) parallel to columns. Belongs-to relation fields don't hold
+// their FK column directly (they hold a pointer to the related struct, which
+// Scan can't populate), so their FK value is scanned into a throwaway
+// destination; call Preload to eager-load the relation itself.
+func scanDestinations(elem reflect.Value, columns []string) ([]any, error) {
+	t := elem.Type()
+	relations := orm.GetRelations(elem.Addr().Interface())
+
+	fkColumns := make(map[string]bool, len(relations))
+	for _, rel := range relations {
+		if rel.Kind == orm.RelationBelongsTo {
+			fkColumns[rel.FKColumn] = true
+		}
+	}
+
+	dest := make([]any, len(columns))
+	for i, col := range columns {
+		if fkColumns[col] {
+			dest[i] = new(sql.NullInt64)
+			continue
+		}
+		field, ok := t.FieldByNameFunc(func(name string) bool {
+			return strings.ToLower(name) == col
+		})
+		if !ok {
+			return nil, fmt.Errorf("liteforge: no field for column %q on %s", col, t.Name())
+		}
+		dest[i] = elem.FieldByIndex(field.Index).Addr().Interface()
+	}
+	return dest, nil
+}
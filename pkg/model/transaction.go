@@ -0,0 +1,95 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pballentine13/liteforge/internal/orm"
+)
+
+// querier returns the connection context-aware repository methods should
+// run their queries against: the transaction, if this repository was built
+// by WithTx, otherwise the shared *sql.DB.
+func (r *ORMRepository) querier() orm.Querier {
+	if r.conn != nil {
+		return r.conn
+	}
+	return r.DS.DB
+}
+
+// WithTx runs fn against a repository backed by a transaction opened via
+// the adapter's BeginTx. The Context variants called through txRepo
+// (SaveContext, UpdateContext, DeleteContext, FindByIDContext) participate
+// in that transaction; the plain Save/Update/Delete/FindByID methods still
+// run on the Datastore's own connection, outside it. A nil error returned by
+// fn commits; a non-nil error rolls
+// back and is returned to the caller unchanged. A panic inside fn is also
+// rolled back, then re-raised so it still surfaces to the caller.
+func (r *ORMRepository) WithTx(ctx context.Context, fn func(txRepo *ORMRepository) error) (err error) {
+	if r.DS == nil {
+		return fmt.Errorf("datastore is nil")
+	}
+
+	tx, err := orm.BeginTx(r.DS)
+	if err != nil {
+		return err
+	}
+
+	txRepo := &ORMRepository{DS: r.DS, callbacks: r.callbacks, conn: tx}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(txRepo); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("%w (rollback also failed: %v)", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// Savepoint establishes a named savepoint within the current transaction,
+// for nested transactional units such as isolating one test case's writes
+// inside an outer WithTx. It only makes sense on a repository obtained from
+// WithTx; calling it on a repository backed directly by *sql.DB returns an error.
+func (r *ORMRepository) Savepoint(ctx context.Context, name string) error {
+	tx, ok := r.conn.(*sql.Tx)
+	if !ok {
+		return fmt.Errorf("liteforge: Savepoint requires a transaction-backed repository (use WithTx)")
+	}
+	name, err := orm.ValidateAndSanitizeAlphaNumeric(name)
+	if err != nil {
+		return fmt.Errorf("liteforge: invalid savepoint name: %w", err)
+	}
+	if _, err := orm.ExecContext(ctx, r.DS, tx, fmt.Sprintf("SAVEPOINT %s", name)); err != nil {
+		return fmt.Errorf("failed to create savepoint %q: %w", name, err)
+	}
+	return nil
+}
+
+// RollbackTo rolls the current transaction back to a savepoint previously
+// established with Savepoint, without aborting the outer transaction.
+func (r *ORMRepository) RollbackTo(ctx context.Context, name string) error {
+	tx, ok := r.conn.(*sql.Tx)
+	if !ok {
+		return fmt.Errorf("liteforge: RollbackTo requires a transaction-backed repository (use WithTx)")
+	}
+	name, err := orm.ValidateAndSanitizeAlphaNumeric(name)
+	if err != nil {
+		return fmt.Errorf("liteforge: invalid savepoint name: %w", err)
+	}
+	if _, err := orm.ExecContext(ctx, r.DS, tx, fmt.Sprintf("ROLLBACK TO SAVEPOINT %s", name)); err != nil {
+		return fmt.Errorf("failed to roll back to savepoint %q: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package notify
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pballentine13/liteforge/internal/orm"
+	"github.com/pballentine13/liteforge/pkg/model"
+)
+
+// ChangeEvent is the JSON payload ChangeCallback publishes, e.g.
+// {"op":"update","table":"users","id":42}.
+type ChangeEvent struct {
+	Op    string `json:"op"`
+	Table string `json:"table"`
+	ID    any    `json:"id"`
+}
+
+// ChangeCallback returns a model.CallbackFunc that publishes a ChangeEvent
+// for op ("create", "update", or "delete") on channel via Notify, for
+// repository code that wants Save/Update/Delete to auto-emit change events
+// for cache invalidation or cross-process fan-out. Register it with
+// Repository.RegisterCallback for the matching event, e.g.:
+//
+//	repo.RegisterCallback("after_create", notify.ChangeCallback("changes", "create"))
+//	repo.RegisterCallback("after_update", notify.ChangeCallback("changes", "update"))
+//	repo.RegisterCallback("after_delete", notify.ChangeCallback("changes", "delete"))
+func ChangeCallback(channel, op string) model.CallbackFunc {
+	return func(ds *orm.Datastore, m any) error {
+		id, err := orm.GetPrimaryKeyValue(m)
+		if err != nil {
+			return fmt.Errorf("notify: change event requires a model with a primary key: %w", err)
+		}
+
+		payload, err := json.Marshal(ChangeEvent{Op: op, Table: orm.GetTableName(m), ID: id})
+		if err != nil {
+			return fmt.Errorf("failed to marshal change event: %w", err)
+		}
+
+		return Notify(ds, channel, string(payload))
+	}
+}
@@ -0,0 +1,154 @@
+package notify
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/pballentine13/liteforge"
+)
+
+// Notification is one payload delivered on a channel the Listener is
+// subscribed to.
+type Notification struct {
+	Channel string
+	Payload string
+}
+
+// ConnState describes a transition in the Listener's underlying
+// connection, delivered on the channel returned by ConnState.
+type ConnState int
+
+const (
+	// StateConnected is delivered once the initial connection succeeds.
+	StateConnected ConnState = iota
+	// StateDisconnected is delivered when the connection is lost, whether
+	// by a dropped connection or a failed reconnect attempt.
+	StateDisconnected
+	// StateReconnected is delivered once a lost connection is re-established.
+	// Some notifications may have been missed while disconnected.
+	StateReconnected
+)
+
+// String renders s for logging.
+func (s ConnState) String() string {
+	switch s {
+	case StateConnected:
+		return "connected"
+	case StateDisconnected:
+		return "disconnected"
+	case StateReconnected:
+		return "reconnected"
+	default:
+		return "unknown"
+	}
+}
+
+// Listener subscribes to Postgres NOTIFY channels, wrapping pq.Listener -
+// which already reconnects with exponential backoff between
+// minReconnectInterval and maxReconnectInterval - and translating its
+// events onto the two channels Notifications and ConnState expose.
+type Listener struct {
+	pql           *pq.Listener
+	notifications chan Notification
+	states        chan ConnState
+}
+
+// NewListener opens a Listener against cfg (which must have DriverName
+// "postgres") and, if channel is non-empty, subscribes to it immediately.
+// Further channels can be added with Listen.
+func NewListener(cfg liteforge.Config, channel string) (*Listener, error) {
+	if cfg.DriverName != "postgres" {
+		return nil, fmt.Errorf("notify: Listener requires a postgres Config, got driver %q", cfg.DriverName)
+	}
+
+	l := &Listener{
+		notifications: make(chan Notification, 64),
+		states:        make(chan ConnState, 8),
+	}
+
+	const minReconnectInterval = 10 * time.Second
+	const maxReconnectInterval = 2 * time.Minute
+	l.pql = pq.NewListener(cfg.DataSourceName, minReconnectInterval, maxReconnectInterval, l.onEvent)
+
+	if channel != "" {
+		if err := l.pql.Listen(channel); err != nil {
+			l.pql.Close()
+			return nil, fmt.Errorf("failed to listen on channel %q: %w", channel, err)
+		}
+	}
+
+	go l.forward()
+	return l, nil
+}
+
+// onEvent is pq.NewListener's EventCallback: it translates pq's own event
+// types onto ConnState and forwards them, dropping the event rather than
+// blocking pq's internal goroutine if nobody is reading ConnState.
+func (l *Listener) onEvent(ev pq.ListenerEventType, err error) {
+	var state ConnState
+	switch ev {
+	case pq.ListenerEventConnected:
+		state = StateConnected
+	case pq.ListenerEventDisconnected, pq.ListenerEventConnectionAttemptFailed:
+		state = StateDisconnected
+	case pq.ListenerEventReconnected:
+		state = StateReconnected
+	default:
+		return
+	}
+
+	select {
+	case l.states <- state:
+	default:
+	}
+}
+
+// forward relays pq.Listener's notification channel onto l.notifications,
+// skipping the nil pq sends after a reconnect to signal "you may have
+// missed notifications while disconnected" - that information already
+// reached ConnState as a StateReconnected event.
+func (l *Listener) forward() {
+	for n := range l.pql.Notify {
+		if n == nil {
+			continue
+		}
+		l.notifications <- Notification{Channel: n.Channel, Payload: n.Extra}
+	}
+	close(l.notifications)
+}
+
+// Notifications returns the channel every notification on a Listen-ed
+// channel is delivered on. It is closed once Close stops the underlying
+// pq.Listener.
+func (l *Listener) Notifications() <-chan Notification {
+	return l.notifications
+}
+
+// ConnState returns the channel connection-state transitions are delivered
+// on, buffered so a slow consumer doesn't stall reconnection.
+func (l *Listener) ConnState() <-chan ConnState {
+	return l.states
+}
+
+// Listen subscribes to an additional channel.
+func (l *Listener) Listen(channel string) error {
+	return l.pql.Listen(channel)
+}
+
+// Unlisten unsubscribes from channel.
+func (l *Listener) Unlisten(channel string) error {
+	return l.pql.Unlisten(channel)
+}
+
+// Ping checks liveness of the underlying connection, for callers that want
+// to detect a stalled Listener without waiting for a notification.
+func (l *Listener) Ping() error {
+	return l.pql.Ping()
+}
+
+// Close stops the Listener and its underlying connection.
+func (l *Listener) Close() error {
+	return l.pql.Close()
+}
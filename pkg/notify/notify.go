@@ -0,0 +1,32 @@
+// Package notify wraps Postgres's LISTEN/NOTIFY in a Liteforge-shaped API:
+// Notify publishes a payload on a channel through a Datastore, and Listener
+// subscribes to one, handling reconnection and surfacing connection-state
+// transitions so callers can build cache invalidation or cross-process
+// fan-out without polling.
+package notify
+
+import (
+	"fmt"
+
+	"github.com/pballentine13/liteforge/internal/orm"
+)
+
+// Notify publishes payload on channel via Postgres's pg_notify(channel,
+// payload), which - unlike the NOTIFY statement - accepts both as bound
+// parameters rather than requiring the payload be a quoted string literal.
+// It returns an error for any adapter other than PostgresAdapter (SQLite
+// has no equivalent pub-sub mechanism).
+func Notify(ds *orm.Datastore, channel, payload string) error {
+	if ds == nil || ds.DB == nil || ds.Adapter == nil {
+		return fmt.Errorf("datastore, database connection, or adapter was nil")
+	}
+	if _, ok := ds.Adapter.(*orm.PostgresAdapter); !ok {
+		return fmt.Errorf("notify: NOTIFY is only supported on PostgresAdapter")
+	}
+
+	_, err := orm.Exec(ds, "SELECT pg_notify($1, $2)", channel, payload)
+	if err != nil {
+		return fmt.Errorf("failed to notify channel %q: %w", channel, err)
+	}
+	return nil
+}
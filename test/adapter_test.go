@@ -42,14 +42,20 @@ func TestAdapterCreateTableSQL(t *testing.T) {
 		{
 			name:    "SQLite Create Table",
 			adapter: &orm.SQLiteAdapter{},
-			// Expected: CREATE TABLE IF NOT EXISTS testuser (id INTEGER PRIMARY KEY NOT NULL, username TEXT UNIQUE NOT NULL, email TEXT NOT NULL UNIQUE, age INTEGER , isactive BOOLEAN )
-			expected: "CREATE TABLE IF NOT EXISTS testuser (id INTEGER PRIMARY KEY NOT NULL, username TEXT UNIQUE NOT NULL, email TEXT NOT NULL UNIQUE, age INTEGER , isactive BOOLEAN )",
+			// Expected: CREATE TABLE IF NOT EXISTS "testuser" ("id" INTEGER PRIMARY KEY NOT NULL, "username" TEXT UNIQUE NOT NULL, "email" TEXT NOT NULL UNIQUE, "age" INTEGER , "isactive" BOOLEAN )
+			expected: `CREATE TABLE IF NOT EXISTS "testuser" ("id" INTEGER PRIMARY KEY NOT NULL, "username" TEXT UNIQUE NOT NULL, "email" TEXT NOT NULL UNIQUE, "age" INTEGER , "isactive" BOOLEAN )`,
 		},
 		{
 			name:    "Postgres Create Table",
 			adapter: &orm.PostgresAdapter{},
-			// Expected: CREATE TABLE IF NOT EXISTS testuser (id SERIAL PRIMARY KEY NOT NULL, username TEXT UNIQUE NOT NULL, email TEXT NOT NULL UNIQUE, age INTEGER , isactive BOOLEAN )
-			expected: "CREATE TABLE IF NOT EXISTS testuser (id SERIAL PRIMARY KEY NOT NULL, username TEXT UNIQUE NOT NULL, email TEXT NOT NULL UNIQUE, age INTEGER , isactive BOOLEAN )",
+			// Expected: CREATE TABLE IF NOT EXISTS "testuser" ("id" SERIAL PRIMARY KEY NOT NULL, "username" TEXT UNIQUE NOT NULL, "email" TEXT NOT NULL UNIQUE, "age" INTEGER , "isactive" BOOLEAN )
+			expected: `CREATE TABLE IF NOT EXISTS "testuser" ("id" SERIAL PRIMARY KEY NOT NULL, "username" TEXT UNIQUE NOT NULL, "email" TEXT NOT NULL UNIQUE, "age" INTEGER , "isactive" BOOLEAN )`,
+		},
+		{
+			name:    "MySQL Create Table",
+			adapter: &orm.MySQLAdapter{},
+			// Expected: CREATE TABLE IF NOT EXISTS `testuser` (`id` INT AUTO_INCREMENT PRIMARY KEY NOT NULL, `username` VARCHAR(255) UNIQUE NOT NULL, `email` VARCHAR(255) NOT NULL UNIQUE, `age` INT , `isactive` TINYINT(1) ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4
+			expected: "CREATE TABLE IF NOT EXISTS `testuser` (`id` INT AUTO_INCREMENT PRIMARY KEY NOT NULL, `username` VARCHAR(255) UNIQUE NOT NULL, `email` VARCHAR(255) NOT NULL UNIQUE, `age` INT , `isactive` TINYINT(1) ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
 		},
 	}
 
@@ -69,3 +75,24 @@ func TestAdapterCreateTableSQL(t *testing.T) {
 		})
 	}
 }
+
+func TestAdapterQuoteIdent(t *testing.T) {
+	tests := []struct {
+		name     string
+		adapter  orm.DBAdapter
+		expected string
+	}{
+		{"SQLite", &orm.SQLiteAdapter{}, `"order"`},
+		{"Postgres", &orm.PostgresAdapter{}, `"order"`},
+		{"MySQL", &orm.MySQLAdapter{}, "`order`"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.adapter.QuoteIdent("order")
+			if actual != tt.expected {
+				t.Errorf("QuoteIdent(%q) got = %s, want %s", "order", actual, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package lightforge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pballentine13/liteforge"
+)
+
+type BatchWidget struct {
+	ID    int `pk:"true"`
+	Name  string
+	Price float64
+}
+
+func setupBatchDB(t *testing.T) (*liteforge.Datastore, func()) {
+	ds, cleanup := openTestDB(t)
+	assert.NoError(t, liteforge.CreateTable(ds, BatchWidget{}))
+	return ds, cleanup
+}
+
+func TestInsertMulti_ChunksUnderMaxParams(t *testing.T) {
+	ds, cleanup := setupBatchDB(t)
+	defer cleanup()
+
+	widgets := make([]BatchWidget, 0, 10)
+	for i := 0; i < 10; i++ {
+		widgets = append(widgets, BatchWidget{Name: "widget", Price: 1.5})
+	}
+
+	result, err := liteforge.InsertMulti(ds, widgets, 3)
+	assert.NoError(t, err)
+
+	affected, err := result.RowsAffected()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), affected)
+
+	var count int
+	assert.NoError(t, ds.DB.QueryRow("SELECT COUNT(*) FROM batchwidget").Scan(&count))
+	assert.Equal(t, 10, count)
+}
+
+func TestInsertMulti_EmptySlice(t *testing.T) {
+	ds, cleanup := setupBatchDB(t)
+	defer cleanup()
+
+	result, err := liteforge.InsertMulti(ds, []BatchWidget{}, 100)
+	assert.NoError(t, err)
+	affected, err := result.RowsAffected()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), affected)
+}
+
+func TestUpdateMulti_UpdatesEachRow(t *testing.T) {
+	ds, cleanup := setupBatchDB(t)
+	defer cleanup()
+
+	repo := liteforge.NewRepository(ds)
+	var ids []int
+	for i := 0; i < 5; i++ {
+		w := &BatchWidget{Name: "old", Price: 1.0}
+		_, err := repo.Save(w)
+		assert.NoError(t, err)
+		ids = append(ids, w.ID)
+	}
+
+	widgets := make([]BatchWidget, 0, 5)
+	for _, id := range ids {
+		widgets = append(widgets, BatchWidget{ID: id, Name: "new", Price: 2.0})
+	}
+
+	result, err := liteforge.UpdateMulti(ds, widgets, 2)
+	assert.NoError(t, err)
+	affected, err := result.RowsAffected()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), affected)
+
+	var count int
+	assert.NoError(t, ds.DB.QueryRow("SELECT COUNT(*) FROM batchwidget WHERE name = 'new'").Scan(&count))
+	assert.Equal(t, 5, count)
+}
+
+func TestDeleteMulti_DeletesEachRow(t *testing.T) {
+	ds, cleanup := setupBatchDB(t)
+	defer cleanup()
+
+	repo := liteforge.NewRepository(ds)
+	var widgets []BatchWidget
+	for i := 0; i < 5; i++ {
+		w := &BatchWidget{Name: "doomed"}
+		_, err := repo.Save(w)
+		assert.NoError(t, err)
+		widgets = append(widgets, *w)
+	}
+
+	result, err := liteforge.DeleteMulti(ds, widgets, 2)
+	assert.NoError(t, err)
+	affected, err := result.RowsAffected()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), affected)
+
+	var count int
+	assert.NoError(t, ds.DB.QueryRow("SELECT COUNT(*) FROM batchwidget").Scan(&count))
+	assert.Equal(t, 0, count)
+}
@@ -0,0 +1,86 @@
+package lightforge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pballentine13/liteforge"
+	"github.com/pballentine13/liteforge/internal/orm"
+)
+
+type BulkWidget struct {
+	ID   int `pk:"true"`
+	Name string
+}
+
+func setupBulkDB(t *testing.T) (*liteforge.Datastore, func()) {
+	ds, cleanup := openTestDB(t)
+	assert.NoError(t, liteforge.CreateTable(ds, BulkWidget{}))
+	return ds, cleanup
+}
+
+func TestInsertMany_SQLite(t *testing.T) {
+	ds, cleanup := setupBulkDB(t)
+	defer cleanup()
+
+	widgets := []BulkWidget{{Name: "a"}, {Name: "b"}, {Name: "c"}}
+	result, err := liteforge.InsertMany(ds, widgets)
+	assert.NoError(t, err)
+
+	affected, err := result.RowsAffected()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), affected)
+
+	var count int
+	assert.NoError(t, ds.DB.QueryRow("SELECT COUNT(*) FROM bulkwidget").Scan(&count))
+	assert.Equal(t, 3, count)
+}
+
+func TestInsertMany_EmptySlice(t *testing.T) {
+	ds, cleanup := setupBulkDB(t)
+	defer cleanup()
+
+	result, err := liteforge.InsertMany(ds, []BulkWidget{})
+	assert.NoError(t, err)
+	affected, err := result.RowsAffected()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), affected)
+}
+
+func TestSQLiteAdapter_BulkInsert_ChunksUnderMaxParams(t *testing.T) {
+	ds, cleanup := setupBulkDB(t)
+	defer cleanup()
+
+	// One column per row, so MaxBulkParams=3 forces 3 rows per chunk and 4
+	// chunks for 10 rows - exercising the chunking path rather than a
+	// single statement.
+	ds.Adapter = &orm.SQLiteAdapter{MaxBulkParams: 3}
+
+	rows := make([][]any, 0, 10)
+	for i := 0; i < 10; i++ {
+		rows = append(rows, []any{"widget"})
+	}
+
+	result, err := ds.Adapter.BulkInsert(ds.DB, "bulkwidget", []string{"name"}, rows)
+	assert.NoError(t, err)
+
+	affected, err := result.RowsAffected()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), affected)
+
+	var count int
+	assert.NoError(t, ds.DB.QueryRow("SELECT COUNT(*) FROM bulkwidget").Scan(&count))
+	assert.Equal(t, 10, count)
+}
+
+func TestBulkInsert_LastInsertIdUnsupported(t *testing.T) {
+	ds, cleanup := setupBulkDB(t)
+	defer cleanup()
+
+	result, err := liteforge.InsertMany(ds, []BulkWidget{{Name: "a"}})
+	assert.NoError(t, err)
+
+	_, err = result.LastInsertId()
+	assert.Error(t, err)
+}
@@ -0,0 +1,106 @@
+package lightforge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pballentine13/liteforge"
+	"github.com/pballentine13/liteforge/internal/orm"
+)
+
+// CallbackUser exercises the lifecycle hook interfaces directly.
+type CallbackUser struct {
+	ID            int `db:"not null" pk:"true"`
+	Username      string
+	BeforeCreated bool
+	AfterCreated  bool
+	BeforeUpdated bool
+	AfterUpdated  bool
+}
+
+func (u *CallbackUser) BeforeCreate(ds *orm.Datastore) error {
+	u.BeforeCreated = true
+	return nil
+}
+
+func (u *CallbackUser) AfterCreate(ds *orm.Datastore) error {
+	u.AfterCreated = true
+	return nil
+}
+
+func (u *CallbackUser) BeforeUpdate(ds *orm.Datastore) error {
+	u.BeforeUpdated = true
+	return nil
+}
+
+func (u *CallbackUser) AfterUpdate(ds *orm.Datastore) error {
+	u.AfterUpdated = true
+	return nil
+}
+
+func TestRepository_LifecycleHooks(t *testing.T) {
+	cfg := liteforge.Config{DriverName: "sqlite3", DataSourceName: ":memory:"}
+	ds, err := liteforge.OpenDB(cfg)
+	assert.NoError(t, err)
+	defer ds.DB.Close()
+
+	assert.NoError(t, liteforge.CreateTable(ds, CallbackUser{}))
+	repo := liteforge.NewRepository(ds)
+
+	user := &CallbackUser{Username: "hooked"}
+	result, err := repo.Save(user)
+	assert.NoError(t, err)
+	lastID, _ := result.LastInsertId()
+	user.ID = int(lastID)
+	assert.True(t, user.BeforeCreated)
+	assert.True(t, user.AfterCreated)
+	assert.False(t, user.BeforeUpdated)
+
+	user.Username = "hooked2"
+	_, err = repo.Save(user)
+	assert.NoError(t, err)
+	assert.True(t, user.BeforeUpdated)
+	assert.True(t, user.AfterUpdated)
+}
+
+func TestRepository_RegisterCallback(t *testing.T) {
+	cfg := liteforge.Config{DriverName: "sqlite3", DataSourceName: ":memory:"}
+	ds, err := liteforge.OpenDB(cfg)
+	assert.NoError(t, err)
+	defer ds.DB.Close()
+
+	assert.NoError(t, liteforge.CreateTable(ds, CallbackUser{}))
+	repo := liteforge.NewRepository(ds)
+
+	var seenEvents []string
+	repo.RegisterCallback("before_create", func(ds *orm.Datastore, model any) error {
+		seenEvents = append(seenEvents, "before_create")
+		return nil
+	})
+	repo.RegisterCallback("after_create", func(ds *orm.Datastore, model any) error {
+		seenEvents = append(seenEvents, "after_create")
+		return nil
+	})
+
+	_, err = repo.Save(&CallbackUser{Username: "global"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"before_create", "after_create"}, seenEvents)
+}
+
+func TestRepository_BeforeCreateAbortsInsert(t *testing.T) {
+	cfg := liteforge.Config{DriverName: "sqlite3", DataSourceName: ":memory:"}
+	ds, err := liteforge.OpenDB(cfg)
+	assert.NoError(t, err)
+	defer ds.DB.Close()
+
+	assert.NoError(t, liteforge.CreateTable(ds, CallbackUser{}))
+	repo := liteforge.NewRepository(ds)
+
+	repo.RegisterCallback("before_create", func(ds *orm.Datastore, model any) error {
+		return assert.AnError
+	})
+
+	_, err = repo.Save(&CallbackUser{Username: "blocked"})
+	assert.Error(t, err)
+}
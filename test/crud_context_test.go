@@ -0,0 +1,94 @@
+package lightforge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pballentine13/liteforge"
+	"github.com/pballentine13/liteforge/internal/orm"
+)
+
+func setupCrudContextDB(t *testing.T) (*liteforge.Datastore, func()) {
+	ds, cleanup := openTestDB(t)
+
+	_, err := ds.DB.Exec("CREATE TABLE contextwidget (id INTEGER PRIMARY KEY, name TEXT)")
+	assert.NoError(t, err)
+
+	return ds, cleanup
+}
+
+type contextWidget struct {
+	ID   int `pk:"true"`
+	Name string
+}
+
+func TestBeginTxContext_CancelledContextFailsExec(t *testing.T) {
+	ds, cleanup := setupCrudContextDB(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	tx, err := orm.BeginTxContext(ctx, ds)
+	assert.NoError(t, err)
+
+	cancel()
+
+	// database/sql's context-cancel rollback runs asynchronously, so a
+	// plain tx.Exec can race it and return nil; ExecContext checks ctx
+	// itself before running the statement, so it fails deterministically.
+	_, err = tx.ExecContext(ctx, "INSERT INTO contextwidget (id, name) VALUES (1, 'gadget')")
+	assert.Error(t, err)
+}
+
+func TestBeginTxContext_NilDatastore(t *testing.T) {
+	_, err := orm.BeginTxContext(context.Background(), nil)
+	assert.Error(t, err)
+}
+
+func TestGetContext_CancelledContext(t *testing.T) {
+	ds, cleanup := setupCrudContextDB(t)
+	defer cleanup()
+
+	_, err := ds.DB.Exec("INSERT INTO contextwidget (id, name) VALUES (1, 'gadget')")
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var widget contextWidget
+	err = orm.GetContext(ctx, ds.DB, "contextwidget", 1, &widget)
+	assert.Error(t, err)
+}
+
+func TestDeleteContext_RemovesRow(t *testing.T) {
+	ds, cleanup := setupCrudContextDB(t)
+	defer cleanup()
+
+	_, err := ds.DB.Exec("INSERT INTO contextwidget (id, name) VALUES (1, 'gadget')")
+	assert.NoError(t, err)
+
+	assert.NoError(t, orm.DeleteContext(context.Background(), ds.DB, "contextwidget", 1))
+
+	var count int
+	assert.NoError(t, ds.DB.QueryRow("SELECT COUNT(*) FROM contextwidget WHERE id = 1").Scan(&count))
+	assert.Equal(t, 0, count)
+}
+
+func TestDeleteContext_CancelledContext(t *testing.T) {
+	ds, cleanup := setupCrudContextDB(t)
+	defer cleanup()
+
+	_, err := ds.DB.Exec("INSERT INTO contextwidget (id, name) VALUES (1, 'gadget')")
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = orm.DeleteContext(ctx, ds.DB, "contextwidget", 1)
+	assert.Error(t, err)
+
+	var count int
+	assert.NoError(t, ds.DB.QueryRow("SELECT COUNT(*) FROM contextwidget WHERE id = 1").Scan(&count))
+	assert.Equal(t, 1, count)
+}
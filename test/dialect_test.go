@@ -0,0 +1,72 @@
+package lightforge
+
+import (
+	"testing"
+
+	"github.com/pballentine13/liteforge/internal/orm"
+)
+
+func TestDialectRebind(t *testing.T) {
+	tests := []struct {
+		name     string
+		adapter  orm.DBAdapter
+		query    string
+		expected string
+	}{
+		{"SQLite", &orm.SQLiteAdapter{}, "SELECT * FROM t WHERE a = ? AND b = ?", "SELECT * FROM t WHERE a = ? AND b = ?"},
+		{"MySQL", &orm.MySQLAdapter{}, "SELECT * FROM t WHERE a = ? AND b = ?", "SELECT * FROM t WHERE a = ? AND b = ?"},
+		{"Postgres", &orm.PostgresAdapter{}, "SELECT * FROM t WHERE a = ? AND b = ?", "SELECT * FROM t WHERE a = $1 AND b = $2"},
+		{"Oracle", &orm.OracleAdapter{}, "SELECT * FROM t WHERE a = ? AND b = ?", "SELECT * FROM t WHERE a = :1 AND b = :2"},
+		{"Postgres ignores quoted ?", &orm.PostgresAdapter{}, "SELECT * FROM t WHERE a = ? AND b = 'literal?'", "SELECT * FROM t WHERE a = $1 AND b = 'literal?'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.adapter.Rebind(tt.query)
+			if actual != tt.expected {
+				t.Errorf("Rebind(%q) got = %q, want %q", tt.query, actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDialectHasReturningID(t *testing.T) {
+	tests := []struct {
+		name     string
+		adapter  orm.DBAdapter
+		expected bool
+	}{
+		{"SQLite", &orm.SQLiteAdapter{}, false},
+		{"MySQL", &orm.MySQLAdapter{}, false},
+		{"Postgres", &orm.PostgresAdapter{}, true},
+		{"Oracle", &orm.OracleAdapter{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := tt.adapter.HasReturningID(); actual != tt.expected {
+				t.Errorf("HasReturningID() got = %v, want %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDialectAppendReturning(t *testing.T) {
+	adapter := &orm.PostgresAdapter{}
+	got := adapter.AppendReturning("INSERT INTO widgets (name) VALUES ($1)", "id")
+	want := `INSERT INTO widgets (name) VALUES ($1) RETURNING "id"`
+	if got != want {
+		t.Errorf("AppendReturning() got = %q, want %q", got, want)
+	}
+}
+
+func TestOracleAdapter_CreateTableSQL(t *testing.T) {
+	adapter := &orm.OracleAdapter{}
+	sqlStr, err := adapter.CreateTableSQL(TestUser{})
+	if err != nil {
+		t.Fatalf("CreateTableSQL returned error: %v", err)
+	}
+	if sqlStr == "" {
+		t.Error("expected a non-empty CREATE TABLE statement")
+	}
+}
@@ -0,0 +1,89 @@
+package lightforge
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pballentine13/liteforge"
+	"github.com/pballentine13/liteforge/internal/orm"
+)
+
+type GCSession struct {
+	ID        string    `pk:"true"`
+	ExpiresAt time.Time `gc:"expiresat"`
+}
+
+func setupGCDB(t *testing.T) (*liteforge.Datastore, func()) {
+	ds, cleanup := openTestDB(t)
+	assert.NoError(t, liteforge.CreateTable(ds, GCSession{}))
+	return ds, cleanup
+}
+
+type recordingObserver struct {
+	sweeps []struct {
+		table   string
+		deleted int
+		err     error
+	}
+}
+
+func (o *recordingObserver) OnSweep(table string, deleted int, err error) {
+	o.sweeps = append(o.sweeps, struct {
+		table   string
+		deleted int
+		err     error
+	}{table, deleted, err})
+}
+
+func TestGC_SweepDeletesExpiredRows(t *testing.T) {
+	ds, cleanup := setupGCDB(t)
+	defer cleanup()
+
+	repo := liteforge.NewRepository(ds)
+	_, err := repo.Save(&GCSession{ID: "expired", ExpiresAt: time.Now().Add(-time.Hour)})
+	assert.NoError(t, err)
+	_, err = repo.Save(&GCSession{ID: "alive", ExpiresAt: time.Now().Add(time.Hour)})
+	assert.NoError(t, err)
+
+	observer := &recordingObserver{}
+	gc := orm.NewGC(ds, orm.GCOptions{Observer: observer, Models: []any{GCSession{}}})
+	gc.Sweep()
+
+	assert.Len(t, observer.sweeps, 1)
+	assert.Equal(t, "gcsession", observer.sweeps[0].table)
+	assert.Equal(t, 1, observer.sweeps[0].deleted)
+	assert.NoError(t, observer.sweeps[0].err)
+
+	var count int
+	assert.NoError(t, ds.DB.QueryRow("SELECT COUNT(*) FROM gcsession").Scan(&count))
+	assert.Equal(t, 1, count)
+}
+
+func TestGC_RegisterWithoutTagOrColumnErrors(t *testing.T) {
+	ds, cleanup := setupGCDB(t)
+	defer cleanup()
+
+	gc := orm.NewGC(ds, orm.GCOptions{})
+	err := gc.Register(struct{ ID string }{}, "")
+	assert.Error(t, err)
+}
+
+func TestStartGC_StopHaltsFurtherSweeps(t *testing.T) {
+	ds, cleanup := setupGCDB(t)
+	defer cleanup()
+
+	observer := &recordingObserver{}
+	stop := liteforge.StartGC(ds, liteforge.GCOptions{
+		Interval: time.Millisecond,
+		Observer: observer,
+		Models:   []any{GCSession{}},
+	})
+
+	time.Sleep(20 * time.Millisecond)
+	stop()
+	countAfterStop := len(observer.sweeps)
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, countAfterStop, len(observer.sweeps))
+}
@@ -0,0 +1,35 @@
+//go:build integration_mysql
+
+package lightforge
+
+import (
+	"os"
+	"testing"
+
+	"github.com/pballentine13/liteforge"
+)
+
+// TestMySQLIntegration_RoundTrip exercises OpenDB, CreateTable, and Insert
+// against a real MySQL instance. Run with:
+//
+//	MYSQL_DSN="user:pass@tcp(127.0.0.1:3306)/liteforge_test" go test -tags integration_mysql ./test/...
+func TestMySQLIntegration_RoundTrip(t *testing.T) {
+	dsn := os.Getenv("MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("MYSQL_DSN not set; skipping MySQL integration test")
+	}
+
+	ds, err := liteforge.OpenDB(liteforge.Config{DriverName: "mysql", DataSourceName: dsn})
+	if err != nil {
+		t.Fatalf("OpenDB: %v", err)
+	}
+	defer ds.DB.Close()
+
+	if err := liteforge.CreateTable(ds, TestUser{}); err != nil {
+		t.Fatalf("CreateTable: %v", err)
+	}
+
+	if _, err := liteforge.Exec(ds, "INSERT INTO testuser (username, email) VALUES (?, ?)", "grace", "grace@example.com"); err != nil {
+		t.Fatalf("Exec insert: %v", err)
+	}
+}
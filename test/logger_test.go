@@ -0,0 +1,78 @@
+package lightforge
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pballentine13/liteforge"
+)
+
+// recordingLogger captures every LogQuery call it receives, for asserting
+// on instrumentation behavior without parsing log output.
+type recordingLogger struct {
+	mu    sync.Mutex
+	calls []recordedCall
+}
+
+type recordedCall struct {
+	sql      string
+	duration time.Duration
+	err      error
+}
+
+func (l *recordingLogger) LogQuery(ctx context.Context, sql string, args []any, duration time.Duration, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.calls = append(l.calls, recordedCall{sql: sql, duration: duration, err: err})
+}
+
+func (l *recordingLogger) callCount() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.calls)
+}
+
+type LoggerUser struct {
+	ID       int    `db:"not null" pk:"true"`
+	Username string `db:"not null unique"`
+}
+
+func TestLogger_ReceivesQueriesOnCreateTable(t *testing.T) {
+	logger := &recordingLogger{}
+	ds, err := liteforge.OpenDB(liteforge.Config{DriverName: "sqlite3", DataSourceName: ":memory:", Logger: logger})
+	assert.NoError(t, err)
+	defer ds.DB.Close()
+
+	err = liteforge.CreateTable(ds, LoggerUser{})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, logger.callCount())
+	assert.Contains(t, logger.calls[0].sql, "CREATE TABLE")
+	assert.NoError(t, logger.calls[0].err)
+}
+
+func TestLogger_RecordsExecError(t *testing.T) {
+	logger := &recordingLogger{}
+	ds, err := liteforge.OpenDB(liteforge.Config{DriverName: "sqlite3", DataSourceName: ":memory:", Logger: logger})
+	assert.NoError(t, err)
+	defer ds.DB.Close()
+
+	_, err = liteforge.Exec(ds, "INSERT INTO no_such_table (id) VALUES (1)")
+	assert.Error(t, err)
+
+	assert.Equal(t, 1, logger.callCount())
+	assert.Error(t, logger.calls[0].err)
+}
+
+func TestDefaultLogger_UsedWhenUnset(t *testing.T) {
+	ds, err := liteforge.OpenDB(liteforge.Config{DriverName: "sqlite3", DataSourceName: ":memory:"})
+	assert.NoError(t, err)
+	defer ds.DB.Close()
+
+	_, ok := ds.Logger.(*liteforge.DefaultLogger)
+	assert.True(t, ok)
+}
@@ -0,0 +1,137 @@
+package lightforge
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pballentine13/liteforge"
+)
+
+func setupMigrateDB(t *testing.T) (*liteforge.Datastore, func()) {
+	return openTestDB(t)
+}
+
+// versionedMigrations builds the two-step chain every test in this file
+// exercises: 1 creates widgets, 2 adds its price column.
+func versionedMigrations() []liteforge.Migration {
+	return []liteforge.Migration{
+		{
+			ID:   1,
+			Name: "create_widgets",
+			Up: func(tx *sql.Tx) error {
+				_, err := tx.Exec("CREATE TABLE widgets (id INTEGER PRIMARY KEY, name TEXT)")
+				return err
+			},
+			Down: func(tx *sql.Tx) error {
+				_, err := tx.Exec("DROP TABLE widgets")
+				return err
+			},
+		},
+		{
+			ID:   2,
+			Name: "add_widgets_price",
+			Up: func(tx *sql.Tx) error {
+				_, err := tx.Exec("ALTER TABLE widgets ADD COLUMN price REAL")
+				return err
+			},
+			Down: func(tx *sql.Tx) error {
+				// SQLite's ALTER TABLE can't drop a column on every build
+				// this is tested against, so roll back the same way
+				// SQLiteAdapter.dropColumnStatements does: rebuild the
+				// table without it.
+				if _, err := tx.Exec("CREATE TABLE widgets_tmp AS SELECT id, name FROM widgets"); err != nil {
+					return err
+				}
+				if _, err := tx.Exec("DROP TABLE widgets"); err != nil {
+					return err
+				}
+				_, err := tx.Exec("ALTER TABLE widgets_tmp RENAME TO widgets")
+				return err
+			},
+		},
+	}
+}
+
+func TestMigrate_AppliesInOrderAndRecordsHistory(t *testing.T) {
+	ds, cleanup := setupMigrateDB(t)
+	defer cleanup()
+
+	assert.NoError(t, liteforge.Migrate(ds, versionedMigrations()))
+
+	_, err := ds.DB.Exec("INSERT INTO widgets (name, price) VALUES ('gizmo', 9.99)")
+	assert.NoError(t, err)
+
+	var count int
+	assert.NoError(t, ds.DB.QueryRow("SELECT COUNT(*) FROM schema_migrations").Scan(&count))
+	assert.Equal(t, 2, count)
+}
+
+func TestMigrate_IsIdempotent(t *testing.T) {
+	ds, cleanup := setupMigrateDB(t)
+	defer cleanup()
+
+	migrations := versionedMigrations()
+	assert.NoError(t, liteforge.Migrate(ds, migrations))
+	// Re-running against an up-to-date database must not try to re-apply
+	// create_widgets and fail on "table already exists".
+	assert.NoError(t, liteforge.Migrate(ds, migrations))
+}
+
+func TestMigrationStatus_AppliedAndPending(t *testing.T) {
+	ds, cleanup := setupMigrateDB(t)
+	defer cleanup()
+
+	migrations := versionedMigrations()
+	assert.NoError(t, liteforge.MigrateTo(ds, migrations, 1))
+
+	status, err := liteforge.MigrationStatus(ds, migrations)
+	assert.NoError(t, err)
+	assert.Len(t, status.Applied, 1)
+	assert.Equal(t, "create_widgets", status.Applied[0].Name)
+	assert.Len(t, status.Pending, 1)
+	assert.Equal(t, "add_widgets_price", status.Pending[0].Name)
+}
+
+func TestMigrateTo_RollsBackToTarget(t *testing.T) {
+	ds, cleanup := setupMigrateDB(t)
+	defer cleanup()
+
+	migrations := versionedMigrations()
+	assert.NoError(t, liteforge.Migrate(ds, migrations))
+
+	assert.NoError(t, liteforge.MigrateTo(ds, migrations, 1))
+
+	status, err := liteforge.MigrationStatus(ds, migrations)
+	assert.NoError(t, err)
+	assert.Len(t, status.Applied, 1)
+	assert.Equal(t, "create_widgets", status.Applied[0].Name)
+
+	// The rolled-back migration's Down step actually ran.
+	_, err = ds.DB.Exec("SELECT price FROM widgets")
+	assert.Error(t, err)
+}
+
+func TestMigrate_FailedStepLeavesHistoryUnrecorded(t *testing.T) {
+	ds, cleanup := setupMigrateDB(t)
+	defer cleanup()
+
+	migrations := []liteforge.Migration{
+		{
+			ID:   1,
+			Name: "always_fails",
+			Up: func(tx *sql.Tx) error {
+				_, err := tx.Exec("NOT VALID SQL")
+				return err
+			},
+		},
+	}
+
+	assert.Error(t, liteforge.Migrate(ds, migrations))
+
+	status, err := liteforge.MigrationStatus(ds, migrations)
+	assert.NoError(t, err)
+	assert.Empty(t, status.Applied)
+	assert.Len(t, status.Pending, 1)
+}
@@ -0,0 +1,53 @@
+package lightforge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pballentine13/liteforge"
+)
+
+func TestMigrationRegistry_UpDownAndStatus(t *testing.T) {
+	ds, cleanup := setupMigrateDB(t)
+	defer cleanup()
+
+	registry := liteforge.NewMigrationRegistry()
+	for _, m := range versionedMigrations() {
+		registry.Register(m.ID, m.Name, m.Up, m.Down)
+	}
+
+	assert.NoError(t, registry.MigrateUp(ds, 2))
+
+	status, err := registry.Status(ds)
+	assert.NoError(t, err)
+	assert.Len(t, status.Applied, 2)
+	assert.Empty(t, status.Pending)
+
+	_, err = ds.DB.Exec("INSERT INTO widgets (id, name, price) VALUES (1, 'gadget', 9.99)")
+	assert.NoError(t, err)
+
+	assert.NoError(t, registry.MigrateDown(ds, 1))
+
+	status, err = registry.Status(ds)
+	assert.NoError(t, err)
+	assert.Len(t, status.Applied, 1)
+	assert.Len(t, status.Pending, 1)
+}
+
+type migratedWidget struct {
+	ID    int `pk:"true"`
+	Name  string
+	Price float64
+}
+
+func TestAutoMigrate_CreatesTable(t *testing.T) {
+	ds, cleanup := setupMigrateDB(t)
+	defer cleanup()
+
+	assert.NoError(t, liteforge.AutoMigrate(ds, migratedWidget{}))
+
+	hasTable, err := ds.Migrator().HasTable(migratedWidget{})
+	assert.NoError(t, err)
+	assert.True(t, hasTable)
+}
@@ -0,0 +1,120 @@
+package lightforge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pballentine13/liteforge"
+)
+
+type MigrationUser struct {
+	ID       int    `db:"not null" pk:"true"`
+	Username string `liteforge:"unique"`
+}
+
+// MigrationUserWithBio is the same table as MigrationUser one version later,
+// with a Bio column added - used to exercise AddColumn/DropColumn/AutoMigrate
+// against a table that was created under an earlier shape.
+type MigrationUserWithBio struct {
+	ID       int    `db:"not null" pk:"true"`
+	Username string `liteforge:"unique"`
+	Bio      string `liteforge:"index"`
+}
+
+func setupMigratorDB(t *testing.T) (*liteforge.Datastore, func()) {
+	return openTestDB(t)
+}
+
+func TestMigrator_HasTable(t *testing.T) {
+	ds, cleanup := setupMigratorDB(t)
+	defer cleanup()
+
+	migrator := ds.Migrator()
+
+	has, err := migrator.HasTable(MigrationUser{})
+	assert.NoError(t, err)
+	assert.False(t, has)
+
+	assert.NoError(t, liteforge.CreateTable(ds, MigrationUser{}))
+
+	has, err = migrator.HasTable(MigrationUser{})
+	assert.NoError(t, err)
+	assert.True(t, has)
+}
+
+func TestMigrator_AddColumnAndHasColumn(t *testing.T) {
+	ds, cleanup := setupMigratorDB(t)
+	defer cleanup()
+
+	// Create the table under its pre-Bio shape, at the name
+	// MigrationUserWithBio resolves to, so AddColumn reconciles it.
+	_, err := ds.DB.Exec("CREATE TABLE migrationuserwithbio (id INTEGER PRIMARY KEY, username TEXT)")
+	assert.NoError(t, err)
+
+	migrator := ds.Migrator()
+
+	has, err := migrator.HasColumn(MigrationUserWithBio{}, "bio")
+	assert.NoError(t, err)
+	assert.False(t, has)
+
+	assert.NoError(t, migrator.AddColumn(MigrationUserWithBio{}, "Bio"))
+
+	has, err = migrator.HasColumn(MigrationUserWithBio{}, "bio")
+	assert.NoError(t, err)
+	assert.True(t, has)
+}
+
+func TestMigrator_DropColumn(t *testing.T) {
+	ds, cleanup := setupMigratorDB(t)
+	defer cleanup()
+	assert.NoError(t, liteforge.CreateTable(ds, MigrationUserWithBio{}))
+
+	_, err := ds.DB.Exec("INSERT INTO migrationuserwithbio (username, bio) VALUES ('grace', 'hello')")
+	assert.NoError(t, err)
+
+	migrator := ds.Migrator()
+	assert.NoError(t, migrator.DropColumn(MigrationUserWithBio{}, "bio"))
+
+	has, err := migrator.HasColumn(MigrationUserWithBio{}, "bio")
+	assert.NoError(t, err)
+	assert.False(t, has)
+
+	var username string
+	err = ds.DB.QueryRow("SELECT username FROM migrationuserwithbio").Scan(&username)
+	assert.NoError(t, err)
+	assert.Equal(t, "grace", username)
+}
+
+func TestMigrator_CreateAndDropIndex(t *testing.T) {
+	ds, cleanup := setupMigratorDB(t)
+	defer cleanup()
+	assert.NoError(t, liteforge.CreateTable(ds, MigrationUser{}))
+
+	migrator := ds.Migrator()
+
+	assert.NoError(t, migrator.CreateIndex(MigrationUser{}, "idx_migrationuser_username", "username"))
+	assert.NoError(t, migrator.DropIndex(MigrationUser{}, "idx_migrationuser_username"))
+}
+
+func TestMigrator_AutoMigrate(t *testing.T) {
+	ds, cleanup := setupMigratorDB(t)
+	defer cleanup()
+
+	migrator := ds.Migrator()
+
+	// First run creates the table (and its unique index) from scratch.
+	assert.NoError(t, migrator.AutoMigrate(MigrationUser{}))
+	has, err := migrator.HasTable(MigrationUser{})
+	assert.NoError(t, err)
+	assert.True(t, has)
+
+	// A later model shape with an extra field gets that column backfilled.
+	_, err = ds.DB.Exec("CREATE TABLE migrationuserwithbio (id INTEGER PRIMARY KEY, username TEXT)")
+	assert.NoError(t, err)
+	assert.NoError(t, migrator.AutoMigrate(MigrationUserWithBio{}))
+
+	has, err = migrator.HasColumn(MigrationUserWithBio{}, "bio")
+	assert.NoError(t, err)
+	assert.True(t, has)
+}
@@ -0,0 +1,60 @@
+package lightforge
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pballentine13/liteforge"
+	"github.com/pballentine13/liteforge/pkg/model"
+	"github.com/pballentine13/liteforge/pkg/notify"
+)
+
+type NotifyUser struct {
+	ID   int `pk:"true"`
+	Name string
+}
+
+func TestNotify_ErrorsOnNonPostgresAdapter(t *testing.T) {
+	ds, err := liteforge.OpenDB(liteforge.Config{DriverName: "sqlite3", DataSourceName: ":memory:"})
+	assert.NoError(t, err)
+	defer ds.DB.Close()
+
+	err = notify.Notify(ds, "changes", "hello")
+	assert.Error(t, err)
+}
+
+func TestNewListener_RejectsNonPostgresConfig(t *testing.T) {
+	_, err := notify.NewListener(liteforge.Config{DriverName: "sqlite3", DataSourceName: ":memory:"}, "changes")
+	assert.Error(t, err)
+}
+
+func TestConnState_String(t *testing.T) {
+	assert.Equal(t, "connected", notify.StateConnected.String())
+	assert.Equal(t, "disconnected", notify.StateDisconnected.String())
+	assert.Equal(t, "reconnected", notify.StateReconnected.String())
+}
+
+func TestChangeEvent_JSONShape(t *testing.T) {
+	event := notify.ChangeEvent{Op: "update", Table: "users", ID: 42}
+	data, err := json.Marshal(event)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"op":"update","table":"users","id":42}`, string(data))
+}
+
+func TestChangeCallback_PlumbsThroughToNotify(t *testing.T) {
+	ds, err := liteforge.OpenDB(liteforge.Config{DriverName: "sqlite3", DataSourceName: ":memory:"})
+	assert.NoError(t, err)
+	defer ds.DB.Close()
+
+	repo := model.NewORMRepository(ds)
+	repo.RegisterCallback("after_create", notify.ChangeCallback("changes", "create"))
+
+	// SQLite has no NOTIFY equivalent, so the registered callback - and
+	// therefore Save itself - surfaces Notify's error rather than silently
+	// dropping the event.
+	assert.NoError(t, liteforge.CreateTable(ds, NotifyUser{}))
+	_, err = repo.Save(&NotifyUser{Name: "grace"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,126 @@
+package lightforge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pballentine13/liteforge"
+	"github.com/pballentine13/liteforge/internal/orm"
+)
+
+// setupORMQuerySetDB is like setupQuerySetDB but returns the raw *orm.Datastore
+// so tests can reach the QuerySet-only Datastore.Query method directly,
+// without going through a Repository.
+func setupORMQuerySetDB(t *testing.T) (*orm.Datastore, func()) {
+	ds, cleanup := openTestDB(t)
+	assert.NoError(t, liteforge.CreateTable(ds, TestUser{}))
+	return ds, cleanup
+}
+
+func seedORMQuerySetUsers(t *testing.T, ds *orm.Datastore) {
+	t.Helper()
+	users := []*TestUser{
+		{Username: "alice", Email: "alice@example.com", Age: 25, IsActive: true},
+		{Username: "bob", Email: "bob@example.com", Age: 40, IsActive: false},
+		{Username: "carol", Email: "carol@example.com", Age: 55, IsActive: true},
+	}
+	for _, u := range users {
+		_, err := orm.Insert(ds, u)
+		assert.NoError(t, err)
+	}
+}
+
+func TestORMQuerySet_FilterAndOrderBy(t *testing.T) {
+	ds, cleanup := setupORMQuerySetDB(t)
+	defer cleanup()
+	seedORMQuerySetUsers(t, ds)
+
+	var results []TestUser
+	err := ds.Query(&TestUser{}).Filter("age__gt", 30).OrderBy("-age").All(&results)
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+	assert.Equal(t, "carol", results[0].Username)
+	assert.Equal(t, "bob", results[1].Username)
+}
+
+func TestORMQuerySet_Exclude(t *testing.T) {
+	ds, cleanup := setupORMQuerySetDB(t)
+	defer cleanup()
+	seedORMQuerySetUsers(t, ds)
+
+	var results []TestUser
+	err := ds.Query(&TestUser{}).Exclude("isactive__exact", true).All(&results)
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "bob", results[0].Username)
+}
+
+func TestORMQuerySet_CountExistsDelete(t *testing.T) {
+	ds, cleanup := setupORMQuerySetDB(t)
+	defer cleanup()
+	seedORMQuerySetUsers(t, ds)
+
+	count, err := ds.Query(&TestUser{}).Filter("isactive__exact", true).Count()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	exists, err := ds.Query(&TestUser{}).Filter("username__exact", "nobody").Exists()
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	result, err := ds.Query(&TestUser{}).Filter("isactive__exact", false).Delete()
+	assert.NoError(t, err)
+	rows, _ := result.RowsAffected()
+	assert.Equal(t, int64(1), rows)
+}
+
+func TestORMQuerySet_Update(t *testing.T) {
+	ds, cleanup := setupORMQuerySetDB(t)
+	defer cleanup()
+	seedORMQuerySetUsers(t, ds)
+
+	result, err := ds.Query(&TestUser{}).Filter("username__exact", "alice").Update(map[string]any{"age": 26})
+	assert.NoError(t, err)
+	rows, _ := result.RowsAffected()
+	assert.Equal(t, int64(1), rows)
+
+	var alice TestUser
+	err = ds.Query(&TestUser{}).Filter("username__exact", "alice").One(&alice)
+	assert.NoError(t, err)
+	assert.Equal(t, 26, alice.Age)
+}
+
+func TestORMQuerySet_GroupByHaving(t *testing.T) {
+	ds, cleanup := setupORMQuerySetDB(t)
+	defer cleanup()
+	seedORMQuerySetUsers(t, ds)
+
+	count, err := ds.Query(&TestUser{}).GroupBy("isactive").Having("COUNT(*) > ?", 1).Count()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count) // only the isactive=true group (2 rows) clears the HAVING bar
+}
+
+func TestORMQuerySet_Related(t *testing.T) {
+	ds, err := orm.OpenDB(orm.Config{DriverName: "sqlite3", DataSourceName: ":memory:"})
+	assert.NoError(t, err)
+	defer ds.DB.Close()
+
+	assert.NoError(t, liteforge.CreateTable(ds, RelUser{}))
+	assert.NoError(t, liteforge.CreateTable(ds, RelPost{}))
+
+	author := &RelUser{Username: "grace"}
+	result, err := orm.Insert(ds, author)
+	assert.NoError(t, err)
+	authorID, _ := result.LastInsertId()
+	author.ID = int(authorID)
+
+	_, err = orm.Insert(ds, &RelPost{Title: "hello world", Author: author})
+	assert.NoError(t, err)
+
+	var posts []RelPost
+	err = ds.Query(&RelPost{}).Related("Author").Filter("username__exact", "grace").All(&posts)
+	assert.NoError(t, err)
+	assert.Len(t, posts, 1)
+	assert.Equal(t, "hello world", posts[0].Title)
+}
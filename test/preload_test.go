@@ -0,0 +1,85 @@
+package lightforge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pballentine13/liteforge"
+	"github.com/pballentine13/liteforge/pkg/model"
+)
+
+type RelUser struct {
+	ID       int `db:"not null" pk:"true"`
+	Username string
+}
+
+type RelComment struct {
+	ID     int `db:"not null" pk:"true"`
+	PostID int
+	Body   string
+}
+
+type RelPost struct {
+	ID       int `db:"not null" pk:"true"`
+	Title    string
+	Author   *RelUser     `liteforge:"fk=userid"`
+	Comments []RelComment `liteforge:"hasmany,fk=postid"`
+}
+
+func setupRelDB(t *testing.T) (*model.ORMRepository, func()) {
+	ds, cleanup := openTestDB(t)
+
+	assert.NoError(t, liteforge.CreateTable(ds, RelUser{}))
+	assert.NoError(t, liteforge.CreateTable(ds, RelComment{}))
+	assert.NoError(t, liteforge.CreateTable(ds, RelPost{}))
+
+	repo := liteforge.NewRepository(ds)
+	return repo, cleanup
+}
+
+func TestPreload_BelongsToAndHasMany(t *testing.T) {
+	repo, cleanup := setupRelDB(t)
+	defer cleanup()
+
+	author := &RelUser{Username: "grace"}
+	result, err := repo.Save(author)
+	assert.NoError(t, err)
+	authorID, _ := result.LastInsertId()
+	author.ID = int(authorID)
+
+	post := &RelPost{Title: "hello world", Author: author}
+	result, err = repo.Save(post)
+	assert.NoError(t, err)
+	postID, _ := result.LastInsertId()
+
+	for _, body := range []string{"first!", "nice post"} {
+		comment := &RelComment{PostID: int(postID), Body: body}
+		_, err := repo.Save(comment)
+		assert.NoError(t, err)
+	}
+
+	var found RelPost
+	err = repo.Preload("Author", "Comments").FindByID(&found, int(postID))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "hello world", found.Title)
+	assert.NotNil(t, found.Author)
+	assert.Equal(t, "grace", found.Author.Username)
+	assert.Len(t, found.Comments, 2)
+}
+
+func TestPreload_BelongsToNilFK(t *testing.T) {
+	repo, cleanup := setupRelDB(t)
+	defer cleanup()
+
+	post := &RelPost{Title: "orphan"}
+	result, err := repo.Save(post)
+	assert.NoError(t, err)
+	postID, _ := result.LastInsertId()
+
+	var found RelPost
+	err = repo.Preload("Author").FindByID(&found, int(postID))
+	assert.NoError(t, err)
+	assert.Nil(t, found.Author)
+}
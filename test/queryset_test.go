@@ -0,0 +1,111 @@
+package lightforge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pballentine13/liteforge"
+	"github.com/pballentine13/liteforge/pkg/model"
+)
+
+// setupQuerySetDB is like setupTestDB but returns the concrete *model.ORMRepository
+// so tests can reach the QuerySet-only Query method.
+func setupQuerySetDB(t *testing.T) (*model.ORMRepository, func()) {
+	ds, cleanup := openTestDB(t)
+	assert.NoError(t, liteforge.CreateTable(ds, TestUser{}))
+
+	repo := liteforge.NewRepository(ds)
+
+	return repo, cleanup
+}
+
+func seedQuerySetUsers(t *testing.T, repo *model.ORMRepository) {
+	t.Helper()
+	users := []*TestUser{
+		{Username: "alice", Email: "alice@example.com", Age: 25, IsActive: true},
+		{Username: "bob", Email: "bob@example.com", Age: 40, IsActive: false},
+		{Username: "carol", Email: "carol@example.com", Age: 55, IsActive: true},
+	}
+	for _, u := range users {
+		_, err := repo.Save(u)
+		assert.NoError(t, err)
+	}
+}
+
+func TestQuerySet_FilterAndOrderBy(t *testing.T) {
+	repo, cleanup := setupQuerySetDB(t)
+	defer cleanup()
+	seedQuerySetUsers(t, repo)
+
+	var results []TestUser
+	err := repo.Query(&TestUser{}).Filter("age__gt", 30).OrderBy("-age").All(&results)
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+	assert.Equal(t, "carol", results[0].Username)
+	assert.Equal(t, "bob", results[1].Username)
+}
+
+func TestQuerySet_FilterIcontains(t *testing.T) {
+	repo, cleanup := setupQuerySetDB(t)
+	defer cleanup()
+	seedQuerySetUsers(t, repo)
+
+	var results []TestUser
+	err := repo.Query(&TestUser{}).Filter("username__icontains", "OB").All(&results)
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "bob", results[0].Username)
+}
+
+func TestQuerySet_CountExistsDelete(t *testing.T) {
+	repo, cleanup := setupQuerySetDB(t)
+	defer cleanup()
+	seedQuerySetUsers(t, repo)
+
+	count, err := repo.Query(&TestUser{}).Filter("isactive__exact", true).Count()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	exists, err := repo.Query(&TestUser{}).Filter("username__exact", "nobody").Exists()
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	result, err := repo.Query(&TestUser{}).Filter("isactive__exact", false).Delete()
+	assert.NoError(t, err)
+	rows, _ := result.RowsAffected()
+	assert.Equal(t, int64(1), rows)
+
+	count, err = repo.Query(&TestUser{}).Count()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestQuerySet_Update(t *testing.T) {
+	repo, cleanup := setupQuerySetDB(t)
+	defer cleanup()
+	seedQuerySetUsers(t, repo)
+
+	result, err := repo.Query(&TestUser{}).Filter("username__exact", "alice").Update(map[string]any{"age": 26})
+	assert.NoError(t, err)
+	rows, _ := result.RowsAffected()
+	assert.Equal(t, int64(1), rows)
+
+	var alice TestUser
+	err = repo.Query(&TestUser{}).Filter("username__exact", "alice").One(&alice)
+	assert.NoError(t, err)
+	assert.Equal(t, 26, alice.Age)
+}
+
+func TestQuerySet_InLookup(t *testing.T) {
+	repo, cleanup := setupQuerySetDB(t)
+	defer cleanup()
+	seedQuerySetUsers(t, repo)
+
+	var results []TestUser
+	err := repo.Query(&TestUser{}).Filter("username__in", []string{"alice", "carol"}).OrderBy("username").All(&results)
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+	assert.Equal(t, "alice", results[0].Username)
+	assert.Equal(t, "carol", results[1].Username)
+}
@@ -0,0 +1,99 @@
+package lightforge
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pballentine13/liteforge"
+)
+
+// tempSQLiteDSN returns a fresh on-disk sqlite DSN, since round-robin
+// routing needs physically distinct databases to tell apart which one
+// answered a given read.
+func tempSQLiteDSN(t *testing.T) string {
+	return filepath.Join(t.TempDir(), "replica.db")
+}
+
+func seedMarkerDB(t *testing.T, dsn, marker string) {
+	ds, err := liteforge.OpenDB(liteforge.Config{DriverName: "sqlite3", DataSourceName: dsn})
+	assert.NoError(t, err)
+	_, err = ds.DB.Exec("CREATE TABLE marker (name TEXT)")
+	assert.NoError(t, err)
+	_, err = ds.DB.Exec("INSERT INTO marker (name) VALUES (?)", marker)
+	assert.NoError(t, err)
+	assert.NoError(t, ds.DB.Close())
+}
+
+func TestOpenDB_ReadReplicasRouteReads(t *testing.T) {
+	primaryDSN := tempSQLiteDSN(t)
+	replicaADSN := tempSQLiteDSN(t)
+	replicaBDSN := tempSQLiteDSN(t)
+
+	seedMarkerDB(t, primaryDSN, "primary")
+	seedMarkerDB(t, replicaADSN, "replica-a")
+	seedMarkerDB(t, replicaBDSN, "replica-b")
+
+	ds, err := liteforge.OpenDB(liteforge.Config{
+		DriverName:     "sqlite3",
+		DataSourceName: primaryDSN,
+		ReadReplicas:   []string{replicaADSN, replicaBDSN},
+	})
+	assert.NoError(t, err)
+	defer ds.DB.Close()
+
+	seen := map[string]bool{}
+	for i := 0; i < 4; i++ {
+		row, err := liteforge.QueryRow(ds, "SELECT name FROM marker")
+		assert.NoError(t, err)
+		var name string
+		assert.NoError(t, row.Scan(&name))
+		seen[name] = true
+	}
+
+	assert.True(t, seen["replica-a"])
+	assert.True(t, seen["replica-b"])
+	assert.False(t, seen["primary"])
+}
+
+func TestReplicaPool_UnhealthyReplicaFallsBackToPrimary(t *testing.T) {
+	primaryDSN := tempSQLiteDSN(t)
+	replicaDSN := tempSQLiteDSN(t)
+
+	seedMarkerDB(t, primaryDSN, "primary")
+	seedMarkerDB(t, replicaDSN, "replica")
+
+	ds, err := liteforge.OpenDB(liteforge.Config{
+		DriverName:     "sqlite3",
+		DataSourceName: primaryDSN,
+		ReadReplicas:   []string{replicaDSN},
+	})
+	assert.NoError(t, err)
+	defer ds.DB.Close()
+
+	replicaDB := ds.Replicas.Next()
+	assert.NotNil(t, replicaDB)
+	assert.NoError(t, replicaDB.Close())
+
+	ds.Replicas.CheckNow()
+
+	row, err := liteforge.QueryRow(ds, "SELECT name FROM marker")
+	assert.NoError(t, err)
+	var name string
+	assert.NoError(t, row.Scan(&name))
+	assert.Equal(t, "primary", name)
+}
+
+func TestDatastore_Ping(t *testing.T) {
+	ds, err := liteforge.OpenDB(liteforge.Config{DriverName: "sqlite3", DataSourceName: tempSQLiteDSN(t)})
+	assert.NoError(t, err)
+	defer ds.DB.Close()
+
+	assert.NoError(t, ds.Ping(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Error(t, ds.Ping(ctx))
+}
@@ -0,0 +1,20 @@
+package lightforge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pballentine13/liteforge"
+)
+
+// openTestDB opens an in-memory sqlite Datastore and returns it alongside a
+// cleanup func that closes it. The per-feature setup*DB helpers throughout
+// this package build on top of this instead of repeating the same
+// OpenDB/Config boilerplate.
+func openTestDB(t *testing.T) (*liteforge.Datastore, func()) {
+	t.Helper()
+	ds, err := liteforge.OpenDB(liteforge.Config{DriverName: "sqlite3", DataSourceName: ":memory:"})
+	assert.NoError(t, err)
+	return ds, func() { ds.DB.Close() }
+}
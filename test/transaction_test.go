@@ -0,0 +1,123 @@
+package lightforge
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pballentine13/liteforge"
+	"github.com/pballentine13/liteforge/pkg/model"
+)
+
+func setupTxDB(t *testing.T) (*model.ORMRepository, func()) {
+	ds, cleanup := openTestDB(t)
+
+	assert.NoError(t, liteforge.CreateTable(ds, TestUser{}))
+
+	repo := liteforge.NewRepository(ds)
+	return repo, cleanup
+}
+
+func TestWithTx_Commit(t *testing.T) {
+	repo, cleanup := setupTxDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	err := repo.WithTx(ctx, func(txRepo *model.ORMRepository) error {
+		user := &TestUser{Username: "txuser", Email: "tx@example.com"}
+		_, err := txRepo.SaveContext(ctx, user)
+		return err
+	})
+	assert.NoError(t, err)
+
+	var found TestUser
+	err = repo.FindByID(&found, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "txuser", found.Username)
+}
+
+func TestWithTx_RollbackOnError(t *testing.T) {
+	repo, cleanup := setupTxDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	sentinel := errors.New("boom")
+	err := repo.WithTx(ctx, func(txRepo *model.ORMRepository) error {
+		user := &TestUser{Username: "rolledback", Email: "rb@example.com"}
+		if _, err := txRepo.SaveContext(ctx, user); err != nil {
+			return err
+		}
+		return sentinel
+	})
+	assert.ErrorIs(t, err, sentinel)
+
+	var found TestUser
+	err = repo.FindByID(&found, 1)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestWithTx_RollbackOnPanic(t *testing.T) {
+	repo, cleanup := setupTxDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	assert.Panics(t, func() {
+		_ = repo.WithTx(ctx, func(txRepo *model.ORMRepository) error {
+			user := &TestUser{Username: "panicker", Email: "panic@example.com"}
+			_, _ = txRepo.SaveContext(ctx, user)
+			panic("unexpected failure")
+		})
+	})
+
+	var found TestUser
+	err := repo.FindByID(&found, 1)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestSavepoint_RollbackTo(t *testing.T) {
+	repo, cleanup := setupTxDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	err := repo.WithTx(ctx, func(txRepo *model.ORMRepository) error {
+		first := &TestUser{Username: "kept", Email: "kept@example.com"}
+		if _, err := txRepo.SaveContext(ctx, first); err != nil {
+			return err
+		}
+
+		if err := txRepo.Savepoint(ctx, "before_second"); err != nil {
+			return err
+		}
+
+		second := &TestUser{Username: "undone", Email: "undone@example.com"}
+		if _, err := txRepo.SaveContext(ctx, second); err != nil {
+			return err
+		}
+
+		return txRepo.RollbackTo(ctx, "before_second")
+	})
+	assert.NoError(t, err)
+
+	var kept TestUser
+	assert.NoError(t, repo.FindByID(&kept, 1))
+	assert.Equal(t, "kept", kept.Username)
+
+	var undone TestUser
+	err = repo.FindByID(&undone, 2)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestSavepoint_RequiresTransaction(t *testing.T) {
+	repo, cleanup := setupTxDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	err := repo.Savepoint(ctx, "nope")
+	assert.Error(t, err)
+
+	err = repo.RollbackTo(ctx, "nope")
+	assert.Error(t, err)
+}
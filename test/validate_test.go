@@ -0,0 +1,74 @@
+package lightforge
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pballentine13/liteforge/internal/orm"
+)
+
+type ValidatedAccount struct {
+	ID       int    `pk:"true"`
+	Username string `validate:"required,min=3,max=16,alphanumeric"`
+	Email    string `validate:"required,email"`
+	Age      int    `validate:"min=0,max=150"`
+	Website  string `validate:"url"`
+}
+
+func TestValidate_Passes(t *testing.T) {
+	account := ValidatedAccount{Username: "grace99", Email: "grace@example.com", Age: 30, Website: "https://example.com"}
+	assert.NoError(t, orm.Validate(&account))
+}
+
+func TestValidate_CollectsEveryFieldFailure(t *testing.T) {
+	account := ValidatedAccount{Username: "gr", Email: "not-an-email", Age: 200, Website: "not a url"}
+	err := orm.Validate(&account)
+	assert.Error(t, err)
+
+	verr, ok := err.(*orm.ValidationError)
+	assert.True(t, ok)
+	assert.Contains(t, verr.Fields, "Username")
+	assert.Contains(t, verr.Fields, "Email")
+	assert.Contains(t, verr.Fields, "Age")
+	assert.Contains(t, verr.Fields, "Website")
+}
+
+func TestValidate_CustomValidatorMethod(t *testing.T) {
+	model := &validatingModel{Username: "alice", allow: true}
+	assert.NoError(t, orm.Validate(model))
+
+	model.allow = false
+	err := orm.Validate(model)
+	assert.Error(t, err)
+}
+
+type validatingModel struct {
+	Username string `validate:"required"`
+	allow    bool
+}
+
+func (m *validatingModel) Validate() error {
+	if !m.allow {
+		return fmt.Errorf("not on the allow list")
+	}
+	return nil
+}
+
+func TestValidate_RegisterValidator(t *testing.T) {
+	orm.RegisterValidator("evenlen", func(value any, _ string) error {
+		s := fmt.Sprintf("%v", value)
+		if len(s)%2 != 0 {
+			return fmt.Errorf("must have an even length")
+		}
+		return nil
+	})
+
+	type Coupon struct {
+		Code string `validate:"evenlen"`
+	}
+
+	assert.NoError(t, orm.Validate(&Coupon{Code: "AB12"}))
+	assert.Error(t, orm.Validate(&Coupon{Code: "ABC"}))
+}